@@ -0,0 +1,153 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"clockify-app/internal/balance"
+	"clockify-app/internal/config"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	balanceSince string
+	balanceJSON  bool
+)
+
+// balanceWeek is the scriptable representation of a ledger line
+type balanceWeek struct {
+	Start          string `json:"start"`
+	ActualSeconds  int64  `json:"actualSeconds"`
+	TargetSeconds  int64  `json:"targetSeconds"`
+	BalanceSeconds int64  `json:"balanceSeconds"`
+}
+
+// balanceResult is what the balance command prints with --json
+type balanceResult struct {
+	Start          string        `json:"start"`
+	End            string        `json:"end"` // Exclusive, the day after today
+	Balance        string        `json:"balance"`
+	BalanceSeconds int64         `json:"balanceSeconds"`
+	ActualSeconds  int64         `json:"actualSeconds"`
+	TargetSeconds  int64         `json:"targetSeconds"`
+	Weeks          []balanceWeek `json:"weeks"`
+}
+
+// balanceCmd represents the balance command
+var balanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Show your overtime balance",
+	Long: `Show how far ahead or behind your work schedule you are, week by week.
+
+The balance compares the time logged up to the end of today with the
+schedule's targets, less holidays and time off. It counts from the
+schedule's balance_since date, or from January 1 when that isn't set.
+Use --since to count from another date, or "ytd" for the start of the year.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, client, err := loadTimerClient()
+		if err != nil {
+			return err
+		}
+
+		schedule := cfg.Schedule
+		switch strings.ToLower(balanceSince) {
+		case "":
+		case "ytd":
+			schedule.BalanceSince = ""
+		default:
+			if _, err := config.ParseDate(balanceSince); err != nil {
+				return fmt.Errorf("--since: %w", err)
+			}
+			schedule.BalanceSince = balanceSince
+		}
+
+		now := time.Now()
+		start := schedule.BalanceStart(now)
+		end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.Local)
+		if !start.Before(end) {
+			return fmt.Errorf("the balance starts on %s, after today", start.Format("2006-01-02"))
+		}
+
+		ledger, err := client.GetLedger(cmd.Context(), cfg.WorkspaceId, cfg.UserId, schedule, start, end)
+		if err != nil {
+			return err
+		}
+
+		return printBalance(cmd.OutOrStdout(), ledger)
+	},
+}
+
+// printBalance writes the ledger as JSON or as a table of weeks with the balance below
+func printBalance(w io.Writer, ledger balance.Ledger) error {
+	if balanceJSON {
+		result := balanceResult{
+			Start:          ledger.Start.Format("2006-01-02"),
+			End:            ledger.End.Format("2006-01-02"),
+			Balance:        balance.Format(ledger.Balance()),
+			BalanceSeconds: int64(ledger.Balance().Seconds()),
+			ActualSeconds:  int64(ledger.Actual.Seconds()),
+			TargetSeconds:  int64(ledger.Target.Seconds()),
+			Weeks:          []balanceWeek{},
+		}
+		for _, week := range ledger.Weeks {
+			result.Weeks = append(result.Weeks, balanceWeek{
+				Start:          week.Start.Format("2006-01-02"),
+				ActualSeconds:  int64(week.Actual.Seconds()),
+				TargetSeconds:  int64(week.Target.Seconds()),
+				BalanceSeconds: int64(week.Balance.Seconds()),
+			})
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Week\tLogged\tTarget\tOvertime\tBalance\t")
+	for _, week := range ledger.Weeks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t\n",
+			week.Start.Format("Mon Jan 2"),
+			formatHours(week.Actual),
+			formatHours(week.Target),
+			balance.Format(week.Overtime()),
+			balance.Format(week.Balance),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nBalance since %s: %s (%s logged of %s)\n",
+		ledger.Start.Format("Jan 2, 2006"),
+		balance.Format(ledger.Balance()),
+		formatHours(ledger.Actual),
+		formatHours(ledger.Target),
+	)
+	return nil
+}
+
+// formatHours shows an unsigned duration in hours and minutes, like 37h 30m
+func formatHours(d time.Duration) string {
+	if d == 0 {
+		return "0h"
+	}
+	return strings.TrimPrefix(balance.Format(d), "+")
+}
+
+func init() {
+	rootCmd.AddCommand(balanceCmd)
+
+	balanceCmd.Flags().StringVar(&balanceSince, "since", "", `Count from this date (YYYY-MM-DD), or "ytd" for the start of the year`)
+	balanceCmd.Flags().BoolVar(&balanceJSON, "json", false, "Print the ledger as JSON")
+}
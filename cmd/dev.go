@@ -0,0 +1,81 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"clockify-app/internal/api/fakeclockify"
+	"clockify-app/internal/config"
+	"clockify-app/internal/models"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	fakeServerAddr      string
+	fakeServerNoSeed    bool
+	fakeServerUseConfig bool
+)
+
+// devCmd groups developer tooling, it is hidden from help output
+var devCmd = &cobra.Command{
+	Use:    "dev",
+	Short:  "Developer tools",
+	Hidden: true,
+}
+
+// fakeServerCmd runs an in-memory Clockify API for driving the app offline
+var fakeServerCmd = &cobra.Command{
+	Use:   "fake-server",
+	Short: "Run a fake Clockify API server",
+	Long: `Run an in-memory Clockify API on a local port.
+
+Point the app at it with CLOCKIFY_BASE_URL. By default the fake accepts the API key,
+user and workspace from your saved config, so the TUI works without being reconfigured.
+Data lives in memory and is lost when the server stops.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fake := fakeclockify.New()
+
+		if fakeServerUseConfig {
+			cfg, err := config.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			fake.UseIdentity(cfg.APIKey, cfg.UserId, models.Workspace{ID: cfg.WorkspaceId, Name: cfg.WorkspaceName})
+		}
+
+		if !fakeServerNoSeed {
+			fake.SeedDemoData(time.Now())
+		}
+
+		if err := fake.StartAt(fakeServerAddr); err != nil {
+			return fmt.Errorf("failed to start fake server: %w", err)
+		}
+		defer fake.Close()
+
+		out := cmd.OutOrStdout()
+		fmt.Fprintf(out, "Fake Clockify API listening on %s\n", fake.URL)
+		fmt.Fprintf(out, "API key: %s\n\n", fake.APIKey)
+		fmt.Fprintf(out, "  %s=%s clockify-app\n\n", config.BaseURLEnv, fake.URL)
+		fmt.Fprintln(out, "Press Ctrl+C to stop.")
+
+		// Execute cancels the context on Ctrl+C
+		<-cmd.Context().Done()
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+	devCmd.AddCommand(fakeServerCmd)
+
+	fakeServerCmd.Flags().StringVar(&fakeServerAddr, "addr", "127.0.0.1:8787", "Address to listen on")
+	fakeServerCmd.Flags().BoolVar(&fakeServerNoSeed, "no-seed", false, "Start with no projects or entries")
+	fakeServerCmd.Flags().BoolVar(&fakeServerUseConfig, "use-config", true, "Accept the API key, user and workspace from the saved config")
+}
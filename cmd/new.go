@@ -18,9 +18,14 @@ var addCmd = &cobra.Command{
 	Short: "Quickly add a new time entry",
 	Long:  "Add a new time entry without having to start the entier app.",
 	Run: func(cmd *cobra.Command, args []string) {
-		p := tea.NewProgram(
-			ui.NewSimpleModel(),
-		)
+		// The form can't start on a config it can't read, say why instead
+		model, err := ui.NewSimpleModel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		p := tea.NewProgram(model)
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
@@ -0,0 +1,59 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// resumeCmd represents the resume command
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Restart the most recent entry as a running timer",
+	Long: `Start a new timer with the description, project and task
+of your most recent time entry.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		cfg, client, err := loadTimerClient()
+		if err != nil {
+			return err
+		}
+
+		entries, err := client.GetEntries(ctx, cfg.WorkspaceId, cfg.UserId)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return errors.New("no previous entries to resume")
+		}
+
+		// Entries come back newest first
+		last := entries[0]
+		if last.IsRunning() {
+			return errors.New("the most recent entry is still running")
+		}
+
+		entry, err := client.StartTimeEntry(ctx, cfg.WorkspaceId, last.ProjectID, last.TaskID, last.Description, last.TagIDs, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return printTimerResult(cmd.OutOrStdout(), timerResult{
+			Action: "resumed",
+			Timer:  describeEntry(ctx, cfg, client, &entry),
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resumeCmd)
+
+	resumeCmd.Flags().BoolVar(&timerJSON, "json", false, "Print the result as JSON")
+}
@@ -4,9 +4,14 @@ Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
 package cmd
 
 import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
 	"clockify-app/internal/ui"
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/spf13/cobra"
@@ -19,12 +24,27 @@ var rootCmd = &cobra.Command{
 	Long: `Clockify-app is a terminal-based application 
 	that allows you to manage your Clockify time entries 
 	directly from the command line.`,
+	// Apply API settings from the config file (and env) to every command
+	// No command can run on a config it can't read, say why instead.
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.LoadConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		api.Configure(api.ConfigOptions(cfg)...)
+	},
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	Run: func(cmd *cobra.Command, args []string) {
-		p := tea.NewProgram(
-			ui.NewModel(),
-		)
+		// The app can't start on a config it can't read, say why instead
+		model, err := ui.NewModel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		p := tea.NewProgram(model)
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
@@ -34,8 +54,12 @@ var rootCmd = &cobra.Command{
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// Interrupting the process cancels any API requests a command has in flight.
 func Execute() error {
-	return rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return rootCmd.ExecuteContext(ctx)
 }
 
 func init() {
@@ -0,0 +1,88 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"clockify-app/internal/models"
+	"clockify-app/internal/utils"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	startProject     string
+	startTask        string
+	startDescription string
+)
+
+// startCmd represents the start command
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start a running timer",
+	Long: `Start a running timer without launching the full app.
+Any timer that is already running is stopped first.
+Projects and tasks can be given by name or ID.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		cfg, client, err := loadTimerClient()
+		if err != nil {
+			return err
+		}
+
+		var project models.Project
+		if startProject != "" {
+			projects, err := client.GetProjects(ctx, cfg.WorkspaceId)
+			if err != nil {
+				return err
+			}
+			if project, err = utils.FindProjectByNameOrId(projects, startProject); err != nil {
+				return err
+			}
+		}
+
+		var task models.Task
+		if startTask != "" {
+			if project.ID == "" {
+				return errors.New("--task requires --project")
+			}
+			tasks, err := client.GetTasks(ctx, cfg.WorkspaceId, project.ID)
+			if err != nil {
+				return err
+			}
+			if task, err = utils.FindTaskByNameOrId(tasks, startTask); err != nil {
+				return err
+			}
+		}
+
+		stopped, err := stopRunningTimer(ctx, cfg, client)
+		if err != nil {
+			return err
+		}
+
+		entry, err := client.StartTimeEntry(ctx, cfg.WorkspaceId, project.ID, task.ID, startDescription, nil, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return printTimerResult(cmd.OutOrStdout(), timerResult{
+			Action:  "started",
+			Timer:   describeEntry(ctx, cfg, client, &entry),
+			Stopped: describeEntry(ctx, cfg, client, stopped),
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(startCmd)
+
+	startCmd.Flags().StringVarP(&startProject, "project", "p", "", "Project name or ID")
+	startCmd.Flags().StringVarP(&startTask, "task", "t", "", "Task name or ID (requires --project)")
+	startCmd.Flags().StringVarP(&startDescription, "description", "d", "", "Description of the work")
+	startCmd.Flags().BoolVar(&timerJSON, "json", false, "Print the result as JSON")
+}
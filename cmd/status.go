@@ -0,0 +1,41 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:           "status",
+	Short:         "Show the running timer",
+	Long:          "Show the running timer, if any, and how long it has been running.",
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		cfg, client, err := loadTimerClient()
+		if err != nil {
+			return err
+		}
+
+		running, err := client.GetRunningTimeEntry(ctx, cfg.WorkspaceId, cfg.UserId)
+		if err != nil {
+			return err
+		}
+
+		return printTimerResult(cmd.OutOrStdout(), timerResult{
+			Action: "status",
+			Timer:  describeEntry(ctx, cfg, client, running),
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().BoolVar(&timerJSON, "json", false, "Print the result as JSON")
+}
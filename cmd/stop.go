@@ -0,0 +1,48 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// stopCmd represents the stop command
+var stopCmd = &cobra.Command{
+	Use:           "stop",
+	Short:         "Stop the running timer",
+	Long:          "Stop the running timer without launching the full app.",
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		cfg, client, err := loadTimerClient()
+		if err != nil {
+			return err
+		}
+
+		stopped, err := stopRunningTimer(ctx, cfg, client)
+		if err != nil {
+			return err
+		}
+		if stopped == nil {
+			// Scripts get the usual object with a null timer, people get an error
+			if timerJSON {
+				return printTimerResult(cmd.OutOrStdout(), timerResult{Action: "stopped"})
+			}
+			return errNoTimer
+		}
+
+		return printTimerResult(cmd.OutOrStdout(), timerResult{
+			Action: "stopped",
+			Timer:  describeEntry(ctx, cfg, client, stopped),
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(stopCmd)
+
+	stopCmd.Flags().BoolVar(&timerJSON, "json", false, "Print the result as JSON")
+}
@@ -0,0 +1,165 @@
+/*
+Copyright © 2026 Kevin Mulholland <kmulholland123@gmail.com>
+*/
+package cmd
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
+	"clockify-app/internal/models"
+	"clockify-app/internal/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// timerJSON switches the timer commands to machine-readable output
+var timerJSON bool
+
+var errNoTimer = errors.New("no timer is running")
+
+// timerEntry is the scriptable representation of a time entry
+type timerEntry struct {
+	ID              string     `json:"id"`
+	Description     string     `json:"description"`
+	ProjectID       string     `json:"projectId,omitempty"`
+	Project         string     `json:"project,omitempty"`
+	TaskID          string     `json:"taskId,omitempty"`
+	Task            string     `json:"task,omitempty"`
+	Start           time.Time  `json:"start"`
+	End             *time.Time `json:"end"`
+	Running         bool       `json:"running"`
+	Duration        string     `json:"duration"`
+	DurationSeconds int64      `json:"durationSeconds"`
+}
+
+// timerResult is what every timer command prints
+type timerResult struct {
+	Action  string      `json:"action"`            // started, stopped, resumed or status
+	Timer   *timerEntry `json:"timer"`             // null when no timer is running
+	Stopped *timerEntry `json:"stopped,omitempty"` // a previous timer stopped to make room
+}
+
+// loadTimerClient loads the saved config and builds an API client from it
+func loadTimerClient() (*config.Config, *api.Client, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if cfg.APIKey == "" || cfg.WorkspaceId == "" || cfg.UserId == "" {
+		return nil, nil, errors.New("clockify-app is not configured, run it without arguments and open Settings")
+	}
+
+	return cfg, api.NewClient(cfg.APIKey), nil
+}
+
+// stopRunningTimer stops the running timer if there is one
+// Returns nil when nothing was running
+func stopRunningTimer(ctx context.Context, cfg *config.Config, client *api.Client) (*models.Entry, error) {
+	running, err := client.GetRunningTimeEntry(ctx, cfg.WorkspaceId, cfg.UserId)
+	if err != nil || running == nil {
+		return nil, err
+	}
+
+	stopped, err := client.StopTimeEntry(ctx, cfg.WorkspaceId, cfg.UserId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &stopped, nil
+}
+
+// describeEntry resolves project and task names for an entry.
+// Name lookups are best effort, a failure only leaves the names empty.
+func describeEntry(ctx context.Context, cfg *config.Config, client *api.Client, entry *models.Entry) *timerEntry {
+	if entry == nil {
+		return nil
+	}
+
+	out := &timerEntry{
+		ID:              entry.ID,
+		Description:     entry.Description,
+		ProjectID:       entry.ProjectID,
+		TaskID:          entry.TaskID,
+		Start:           entry.TimeInterval.Start,
+		Running:         entry.IsRunning(),
+		Duration:        utils.FormatElapsed(entry.Duration()),
+		DurationSeconds: int64(entry.Duration().Seconds()),
+	}
+
+	if !entry.IsRunning() {
+		end := entry.TimeInterval.End
+		out.End = &end
+	}
+
+	// Only the entry's own project and task are fetched, not the whole workspace
+	if entry.ProjectID != "" {
+		if project, err := client.GetProject(ctx, cfg.WorkspaceId, entry.ProjectID); err == nil {
+			out.Project = project.Name
+		}
+	}
+
+	if entry.ProjectID != "" && entry.TaskID != "" {
+		if task, err := client.GetTask(ctx, cfg.WorkspaceId, entry.ProjectID, entry.TaskID); err == nil {
+			out.Task = task.Name
+		}
+	}
+
+	return out
+}
+
+// printTimerResult writes the result as JSON or as a short human readable summary
+func printTimerResult(w io.Writer, result timerResult) error {
+	if timerJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	if result.Stopped != nil {
+		fmt.Fprintf(w, "Stopped: %s (%s)\n", summarizeEntry(result.Stopped), result.Stopped.Duration)
+	}
+
+	if result.Timer == nil {
+		fmt.Fprintln(w, "No timer running")
+		return nil
+	}
+
+	start := result.Timer.Start.In(time.Local).Format("3:04PM")
+
+	switch result.Action {
+	case "started":
+		fmt.Fprintf(w, "Started: %s at %s\n", summarizeEntry(result.Timer), start)
+	case "resumed":
+		fmt.Fprintf(w, "Resumed: %s at %s\n", summarizeEntry(result.Timer), start)
+	case "stopped":
+		fmt.Fprintf(w, "Stopped: %s (%s)\n", summarizeEntry(result.Timer), result.Timer.Duration)
+	default:
+		fmt.Fprintf(w, "Running: %s · %s (since %s)\n", summarizeEntry(result.Timer), result.Timer.Duration, start)
+	}
+
+	return nil
+}
+
+// summarizeEntry joins the description, project and task of an entry
+func summarizeEntry(entry *timerEntry) string {
+	description := entry.Description
+	if description == "" {
+		description = "(No Description)"
+	}
+
+	parts := []string{description}
+	if entry.Project != "" {
+		parts = append(parts, entry.Project)
+	}
+	if entry.Task != "" {
+		parts = append(parts, entry.Task)
+	}
+
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"clockify-app/internal/balance"
+	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
+	"clockify-app/internal/messages"
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// GetLedger works out a user's overtime balance over the local days from start up to end,
+// from the entries logged and the schedule's targets less holidays and time off
+func (c *Client) GetLedger(ctx context.Context, workspaceID, userID string, schedule config.Schedule, start, end time.Time) (balance.Ledger, error) {
+	// Start early enough to catch entries running overnight into the first day
+	entries, err := c.GetEntriesInRange(ctx, workspaceID, userID, start.Add(-MaxEntryDuration), end)
+	if err != nil {
+		return balance.Ledger{}, err
+	}
+
+	daysOff, err := c.GetDaysOff(ctx, workspaceID, userID, schedule, start, end)
+	if err != nil {
+		return balance.Ledger{}, err
+	}
+
+	return balance.Compute(entries, schedule, holidays.NewCalendar(daysOff), start, end), nil
+}
+
+// FetchBalance returns a command that loads the overtime balance from the
+// schedule's balance start up to the end of today
+// Cancelling ctx abandons the fetch without reporting an error.
+func FetchBalance(ctx context.Context, apiKey, workspaceId, userId string, schedule config.Schedule, now time.Time) tea.Cmd {
+	return func() tea.Msg {
+		now = now.In(time.Local)
+		start := schedule.BalanceStart(now)
+		end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.Local)
+
+		client := NewClient(apiKey)
+		ledger, err := client.GetLedger(ctx, workspaceId, userId, schedule, start, end)
+
+		// A superseded fetch has nothing to report
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.BalanceLoadedMsg{Ledger: ledger}
+	}
+}
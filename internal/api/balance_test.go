@@ -0,0 +1,48 @@
+package api
+
+import (
+	"clockify-app/internal/config"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetLedger(t *testing.T) {
+	start := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 7)
+
+	var from time.Time
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workspaces/ws1/user/u1/time-entries":
+			from, _ = time.Parse(time.RFC3339, r.URL.Query().Get("start"))
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			// Sunday night into Monday, and a long Tuesday
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"id": "e1", "timeInterval": map[string]any{"start": start.Add(-2 * time.Hour), "end": start.Add(time.Hour)}},
+				{"id": "e2", "timeInterval": map[string]any{"start": start.AddDate(0, 0, 1).Add(8 * time.Hour), "end": start.AddDate(0, 0, 1).Add(18 * time.Hour)}},
+			})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+
+	ledger, err := client.GetLedger(t.Context(), "ws1", "u1", config.Schedule{WeekStart: "monday"}, start, end)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !from.Equal(start.Add(-MaxEntryDuration)) {
+		t.Errorf("Expected entries from a day before the start, got %v", from)
+	}
+	if ledger.Actual != 11*time.Hour || ledger.Target != 40*time.Hour {
+		t.Errorf("Expected 11h of 40h, got %v of %v", ledger.Actual, ledger.Target)
+	}
+	if len(ledger.Weeks) != 1 || ledger.Weeks[0].Balance != -29*time.Hour {
+		t.Errorf("Expected a week 29h short, got %+v", ledger.Weeks)
+	}
+}
@@ -2,97 +2,249 @@ package api
 
 import (
 	"bytes"
+	"clockify-app/internal/config"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
-// Base URL for all Clockify API requests
-const baseURL = "https://api.clockify.me/api/v1"
+// Defaults used when no option overrides them
+const (
+	DefaultBaseURL   = "https://api.clockify.me/api/v1"
+	DefaultUserAgent = "clockify-tui"
+	DefaultTimeout   = 30 * time.Second
+)
 
 // Client handles all HTTP interactions with the Clockify API
 // It stores the API key and reuses an HTTP client for efficiency
 type Client struct {
 	apiKey     string
+	baseURL    string
+	userAgent  string
+	timeout    time.Duration
 	httpClient *http.Client
+	retry      RetryPolicy
+	limiter    *RateLimiter
+
+	// Pagination settings for list endpoints
+	pageSize int
+	maxPages int
+}
+
+// Option configures a Client in NewClient
+type Option func(*Client)
+
+// WithBaseURL points the client at another Clockify endpoint,
+// e.g. a regional API, a proxy or a local test server
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.baseURL = strings.TrimRight(baseURL, "/")
+		}
+	}
+}
+
+// WithHTTPClient replaces the underlying HTTP client, e.g. to inject a transport
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		if userAgent != "" {
+			c.userAgent = userAgent
+		}
+	}
+}
+
+// WithTimeout sets the timeout applied to each request on top of the caller's context
+// Zero disables the timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+var (
+	defaultOptionsMu sync.RWMutex
+	defaultOptions   []Option
+)
+
+// Configure sets options applied to every client created by NewClient
+// Options passed to NewClient are applied after these
+func Configure(opts ...Option) {
+	defaultOptionsMu.Lock()
+	defer defaultOptionsMu.Unlock()
+
+	defaultOptions = opts
+}
+
+// ConfigOptions returns the client options stored in the user's config
+func ConfigOptions(cfg *config.Config) []Option {
+	if cfg == nil {
+		return nil
+	}
+	return []Option{WithBaseURL(cfg.APIBaseURL())}
 }
 
 // NewClient creates and returns a new Clockify API client
 // This is the constructor function - always use this to create clients
-func NewClient(apiKey string) *Client {
-	return &Client{
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
 		apiKey:     apiKey,
+		baseURL:    DefaultBaseURL,
+		userAgent:  DefaultUserAgent,
+		timeout:    DefaultTimeout,
 		httpClient: &http.Client{}, // Standard HTTP client
+		retry:      DefaultRetryPolicy,
+		limiter:    sharedLimiter,
+		pageSize:   DefaultPageSize,
+		maxPages:   DefaultMaxPages,
+	}
+
+	defaultOptionsMu.RLock()
+	all := append(append([]Option{}, defaultOptions...), opts...)
+	defaultOptionsMu.RUnlock()
+
+	for _, opt := range all {
+		opt(c)
 	}
+
+	return c
 }
 
 // doRequest is a private helper method that performs HTTP requests
 // It handles:
 // - JSON marshaling of request bodies
 // - Adding authentication headers
+// - Waiting on the shared rate limiter before each attempt
+// - Retrying rate-limited, failed and (for idempotent methods) server-error responses
 // - Error handling for non-2xx responses
-func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+// - Cancellation through ctx, with the client's timeout applied to each attempt
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	var payload []byte
 
-	// If we have a body, marshal it to JSON
+	// If we have a body, marshal it to JSON once so it can be resent
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonData)
+		payload = jsonData
+	}
+
+	attempts := max(c.retry.MaxAttempts, 1)
+	for attempt := 1; ; attempt++ {
+		status, header, respBody, reqErr := c.attempt(ctx, method, endpoint, payload)
+
+		if reqErr == nil && status >= 200 && status < 300 {
+			return respBody, nil
+		}
+
+		// Check for HTTP errors
+		err := reqErr
+		if err == nil {
+			err = newAPIError(status, respBody)
+		}
+
+		if attempt >= attempts || !shouldRetry(method, status, reqErr) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		delay := c.retry.backoff(attempt)
+		if wait, ok := retryAfter(header, time.Now()); ok {
+			// Don't hang around when the server asks for a long pause
+			if wait > c.retry.MaxDelay {
+				return nil, err
+			}
+			delay = wait
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends a single request and reads the whole response
+func (c *Client) attempt(ctx context.Context, method, endpoint string, payload []byte) (int, http.Header, []byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return 0, nil, nil, err
+		}
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest(method, baseURL+endpoint, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Add required headers
 	req.Header.Set("X-Api-Key", c.apiKey) // Clockify uses this header for auth
-	if body != nil {
+	req.Header.Set("User-Agent", c.userAgent)
+	if payload != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
 	// Execute the request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return 0, nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close() // Always close the response body
 
 	// Read the response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return 0, nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	// Check for HTTP errors
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
-	}
-
-	return respBody, nil
+	return resp.StatusCode, resp.Header, respBody, nil
 }
 
 // get performs a GET request - convenience wrapper around doRequest
-func (c *Client) Get(endpoint string) ([]byte, error) {
-	return c.doRequest("GET", endpoint, nil)
+func (c *Client) Get(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.doRequest(ctx, "GET", endpoint, nil)
 }
 
 // post performs a POST request - convenience wrapper around doRequest
-func (c *Client) Post(endpoint string, body interface{}) ([]byte, error) {
-	return c.doRequest("POST", endpoint, body)
+func (c *Client) Post(ctx context.Context, endpoint string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, "POST", endpoint, body)
 }
 
 // put performs a PUT request - convenience wrapper around doRequest
-func (c *Client) Put(endpoint string, body interface{}) ([]byte, error) {
-	return c.doRequest("PUT", endpoint, body)
+func (c *Client) Put(ctx context.Context, endpoint string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, "PUT", endpoint, body)
 }
 
 // delete performs a DELETE request - convenience wrapper around doRequest
-func (c *Client) Delete(endpoint string) ([]byte, error) {
-	return c.doRequest("DELETE", endpoint, nil)
+func (c *Client) Delete(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.doRequest(ctx, "DELETE", endpoint, nil)
+}
+
+// patch performs a PATCH request - convenience wrapper around doRequest
+func (c *Client) Patch(ctx context.Context, endpoint string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, "PATCH", endpoint, body)
 }
@@ -1,7 +1,14 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -19,4 +26,160 @@ func TestNewClient(t *testing.T) {
 	if client.httpClient == nil {
 		t.Error("HTTP client should not be nil")
 	}
+
+	if client.baseURL != DefaultBaseURL {
+		t.Errorf("Expected default base URL %q, got %q", DefaultBaseURL, client.baseURL)
+	}
+
+	if client.timeout != DefaultTimeout {
+		t.Errorf("Expected default timeout %v, got %v", DefaultTimeout, client.timeout)
+	}
+}
+
+func TestNewClientOptions(t *testing.T) {
+	injected := &http.Client{}
+	client := NewClient("key",
+		WithBaseURL("https://euc1.clockify.me/api/v1/"),
+		WithHTTPClient(injected),
+		WithUserAgent("my-agent"),
+		WithTimeout(5*time.Second),
+	)
+
+	if client.baseURL != "https://euc1.clockify.me/api/v1" {
+		t.Errorf("Trailing slash should be trimmed, got %q", client.baseURL)
+	}
+	if client.userAgent != "my-agent" {
+		t.Errorf("Expected user agent 'my-agent', got %q", client.userAgent)
+	}
+	if client.timeout != 5*time.Second {
+		t.Errorf("Expected 5s timeout, got %v", client.timeout)
+	}
+	if client.httpClient != injected {
+		t.Error("Injected HTTP client should be used")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}), WithTimeout(20*time.Millisecond))
+	defer close(release)
+
+	_, err := client.Get(t.Context(), "/slow")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestRequestCancelled(t *testing.T) {
+	release := make(chan struct{})
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := client.Get(ctx, "/slow")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context canceled, got %v", err)
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	Configure(WithBaseURL("http://configured.test"))
+	defer Configure()
+
+	if client := NewClient("key"); client.baseURL != "http://configured.test" {
+		t.Errorf("Expected configured base URL, got %q", client.baseURL)
+	}
+
+	// Per-client options win over the configured defaults
+	if client := NewClient("key", WithBaseURL("http://override.test")); client.baseURL != "http://override.test" {
+		t.Errorf("Expected override base URL, got %q", client.baseURL)
+	}
+}
+
+func TestRequestHeaders(t *testing.T) {
+	var got *http.Request
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		_, _ = w.Write([]byte(`{}`))
+	}), WithUserAgent("test-agent"))
+
+	if _, err := client.Post(t.Context(), "/things", map[string]string{"a": "b"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Header.Get("X-Api-Key") != "test-api-key" {
+		t.Error("API key header should be set")
+	}
+	if got.Header.Get("User-Agent") != "test-agent" {
+		t.Errorf("Expected user agent 'test-agent', got %q", got.Header.Get("User-Agent"))
+	}
+	if got.Header.Get("Content-Type") != "application/json" {
+		t.Error("Content type should be JSON when sending a body")
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"nope"}`, http.StatusUnauthorized)
+	}))
+
+	if _, err := client.Get(t.Context(), "/user"); err == nil {
+		t.Error("Expected error for non-2xx response")
+	}
+}
+
+// testOptions keep retries fast and skip the shared rate limiter in tests
+var testOptions = []Option{
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}),
+	WithRateLimiter(nil),
+}
+
+// newTestClient returns a client whose requests are served by handler
+func newTestClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	all := append([]Option{WithBaseURL(server.URL)}, testOptions...)
+	return NewClient("test-api-key", append(all, opts...)...)
+}
+
+// pagedHandler serves items in pages based on the page and page-size query params
+// Every request is recorded so tests can assert on the query string
+func pagedHandler[T any](t *testing.T, items []T, requests *[]*http.Request) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			*requests = append(*requests, r)
+		}
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Errorf("Missing page param in %s", r.URL)
+			page = 1
+		}
+		size, err := strconv.Atoi(r.URL.Query().Get("page-size"))
+		if err != nil {
+			t.Errorf("Missing page-size param in %s", r.URL)
+			size = len(items)
+		}
+
+		start := min((page-1)*size, len(items))
+		end := min(start+size, len(items))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(items[start:end])
+	}
 }
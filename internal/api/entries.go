@@ -4,132 +4,189 @@ import (
 	"clockify-app/internal/cache"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
-	"clockify-app/internal/utils"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 )
 
-// GetEntries fetches time entries for a user in a workspace from Clockify API
-func (c *Client) GetEntries(workspaceId, userId string) ([]models.Entry, error) {
+// RecentEntriesLimit is how many entries GetEntries returns for the entries list
+var RecentEntriesLimit = 100
 
-	pageSize := "30"
-	endpoint := "/workspaces/%s/user/%s/time-entries?page-size=%s"
-	body, err := c.Get(fmt.Sprintf(endpoint, workspaceId, userId, pageSize))
-
-	if err != nil {
+// GetEntries fetches the most recent time entries for a user in a workspace from Clockify API
+func (c *Client) GetEntries(ctx context.Context, workspaceId, userId string) ([]models.Entry, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/user/%s/time-entries", workspaceId, userId)
+	entries, err := getAllPages[models.Entry](ctx, c, endpoint, RecentEntriesLimit)
+	if err != nil && !errors.Is(err, ErrPageLimit) {
 		return nil, err
 	}
 
-	var entries []models.Entry
-	if err := json.Unmarshal(body, &entries); err != nil {
-		return nil, fmt.Errorf("failed to parse entries: %w", err)
-	}
-
+	// Hitting the page cap is fine here, the list only shows recent entries
 	return entries, nil
 }
 
-// FetchEntries returns a command that fetches time entries for a user in a workspace
-func FetchEntries(apiKey, workspaceId, userId string) tea.Cmd {
-	return func() tea.Msg {
-		// Return cached entries if available
-		cache := cache.GetInstance()
-		if cachedEntries := cache.GetEntries(); cachedEntries != nil {
-			return messages.EntriesLoadedMsg{
-				Entries: cachedEntries,
-			}
-		}
+// GetEntriesInRange fetches every time entry that starts within [start, end)
+func (c *Client) GetEntriesInRange(ctx context.Context, workspaceId, userId string, start, end time.Time) ([]models.Entry, error) {
+	endpoint := fmt.Sprintf(
+		"/workspaces/%s/user/%s/time-entries?start=%s&end=%s",
+		workspaceId,
+		userId,
+		start.UTC().Format(time.RFC3339),
+		end.UTC().Format(time.RFC3339),
+	)
+
+	return getAllPages[models.Entry](ctx, c, endpoint, 0)
+}
 
+// WeekRange returns the range fetched for the week starting on weekStart's local date
+// Like DayRange it starts a day early, so entries running overnight into the week are included.
+func WeekRange(weekStart time.Time) (time.Time, time.Time) {
+	weekStart = weekStart.In(time.Local)
+	start := time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day(), 0, 0, 0, 0, time.Local)
+	return start.Add(-MaxEntryDuration), start.AddDate(0, 0, 7)
+}
+
+// MonthRange returns the range fetched for the local month containing date
+// Like DayRange it starts a day early, so entries running overnight into the month are included.
+func MonthRange(date time.Time) (time.Time, time.Time) {
+	date = date.In(time.Local)
+	start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.Local)
+	return start.Add(-MaxEntryDuration), start.AddDate(0, 1, 0)
+}
+
+// FetchEntriesForRange returns a command that fetches time entries for an arbitrary date range
+// The result is tagged with the range so views can drop responses they no longer want.
+// Cancelling ctx abandons the fetch without reporting an error.
+func FetchEntriesForRange(ctx context.Context, apiKey, workspaceId, userId string, start, end time.Time) tea.Cmd {
+	return func() tea.Msg {
 		client := NewClient(apiKey)
-		entries, err := client.GetEntries(workspaceId, userId)
+		entries, err := client.GetEntriesInRange(ctx, workspaceId, userId, start, end)
+
+		// A superseded fetch has nothing to report
+		if ctx.Err() != nil {
+			return nil
+		}
 
 		if err != nil {
 			return messages.ErrorMsg{Err: err}
 		}
 
-		cache.SetEntries(entries)
 		return messages.EntriesLoadedMsg{
 			Entries: entries,
+			Start:   start,
+			End:     end,
 		}
 	}
 }
 
-// FetchEntriesForWeek returns a command that fetches time entries for a specific week
-func FetchEntriesForWeek(apiKey, workspaceId, userId string, weekStart time.Time) tea.Cmd {
-	return func() tea.Msg {
-		client := NewClient(apiKey)
+// FetchEntries returns a command that fetches time entries for a user in a workspace
+// Cached entries are shown straight away, stale or not; RevalidateEntries refreshes them.
+func FetchEntries(apiKey, workspaceId, userId string) tea.Cmd {
+	return entriesCmd(apiKey, workspaceId, userId, fromCache)
+}
 
-		// Calculate start and end of the week
-		weekEnd := weekStart.AddDate(0, 0, 7)
-		startStr := weekStart.Format("2006-01-02")
-		endStr := weekEnd.Format("2006-01-02")
+// RevalidateEntries returns a command that refetches the cached entries when they're stale
+func RevalidateEntries(apiKey, workspaceId, userId string) tea.Cmd {
+	return entriesCmd(apiKey, workspaceId, userId, revalidate)
+}
 
-		endpoint := "/workspaces/%s/user/%s/time-entries?start=%sT00:00:00Z&end=%sT00:00:00Z"
-		body, err := client.Get(fmt.Sprintf(endpoint, workspaceId, userId, startStr, endStr))
+func entriesCmd(apiKey, workspaceId, userId string, serve serveFunc[[]models.Entry]) tea.Cmd {
+	return func() tea.Msg {
+		cache := cache.GetInstance()
+		entries, fresh := cache.LookupEntries()
+
+		return serve(
+			cached[[]models.Entry]{data: entries, found: entries != nil, fresh: fresh},
+			func() ([]models.Entry, error) {
+				client := NewClient(apiKey)
+				entries, err := client.GetEntries(context.Background(), workspaceId, userId)
+				if err != nil {
+					return nil, err
+				}
+				cache.SetEntries(entries)
+				return entries, nil
+			},
+			func(entries []models.Entry) tea.Msg {
+				return messages.EntriesLoadedMsg{Entries: entries}
+			},
+		)
+	}
+}
 
-		if err != nil {
-			return messages.ErrorMsg{Err: err}
-		}
+// FetchEntriesForWeek returns a command that fetches time entries for a specific week
+func FetchEntriesForWeek(ctx context.Context, apiKey, workspaceId, userId string, weekStart time.Time) tea.Cmd {
+	start, end := WeekRange(weekStart)
+	return FetchEntriesForRange(ctx, apiKey, workspaceId, userId, start, end)
+}
 
-		var entries []models.Entry
-		if err := json.Unmarshal(body, &entries); err != nil {
-			return messages.ErrorMsg{Err: fmt.Errorf("failed to parse entries: %w", err)}
-		}
+// FetchEntriesForMonth returns a command that fetches time entries for a specific month
+func FetchEntriesForMonth(ctx context.Context, apiKey, workspaceId, userId string, requestedDate time.Time) tea.Cmd {
+	start, end := MonthRange(requestedDate)
+	return FetchEntriesForRange(ctx, apiKey, workspaceId, userId, start, end)
+}
 
-		return messages.EntriesLoadedMsg{
-			Entries: entries,
-		}
-	}
+// DayRange returns the range fetched to check entries on day's date
+// It starts a day early, so entries running overnight into the day are included.
+func DayRange(day time.Time) (time.Time, time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return start.Add(-MaxEntryDuration), start.AddDate(0, 0, 1)
 }
 
-// FetchEntriesForWeek returns a command that fetches time entries for a specific week
-func FetchEntriesForMonth(apiKey, workspaceId, userId string, requestedDate time.Time) tea.Cmd {
+// FetchDayEntries returns a command that loads the entries around day
+// The cached recent entries are used when they reach back far enough.
+func FetchDayEntries(apiKey, workspaceId, userId string, day time.Time) tea.Cmd {
 	return func() tea.Msg {
-		client := NewClient(apiKey)
+		start, end := DayRange(day)
 
-		monthStart := time.Date(requestedDate.Year(), requestedDate.Month(), 1, 0, 0, 0, 0, time.UTC)
-		monthEnd := time.Date(requestedDate.Year(), requestedDate.Month()+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
-
-		startStr := monthStart.Format("2006-01-02")
-		endStr := monthEnd.Format("2006-01-02")
+		cached := cache.GetInstance().GetEntries()
+		if len(cached) > 0 && !cached[len(cached)-1].TimeInterval.Start.After(start) {
+			return messages.DayEntriesLoadedMsg{Date: day, Entries: cached}
+		}
 
-		endpoint := "/workspaces/%s/user/%s/time-entries?start=%sT00:00:00Z&end=%sT23:59:59Z"
-		body, err := client.Get(fmt.Sprintf(endpoint, workspaceId, userId, startStr, endStr))
+		client := NewClient(apiKey)
+		entries, err := client.GetEntriesInRange(context.Background(), workspaceId, userId, start, end)
 		if err != nil {
 			return messages.ErrorMsg{Err: err}
 		}
 
-		var entries []models.Entry
-		if err := json.Unmarshal(body, &entries); err != nil {
-			return messages.ErrorMsg{Err: fmt.Errorf("failed to parse entries: %w", err)}
-		}
-
-		return messages.EntriesLoadedMsg{Entries: entries}
+		return messages.DayEntriesLoadedMsg{Date: day, Entries: entries}
 	}
 }
 
-// CreateTimeEntry creates a new time entry in Clockify
-// Takes all the necessary parameters and returns an error if creation fails
-func (c *Client) CreateTimeEntry(workspaceID, projectID, taskID, description, startTimeStr, endTimeStr string, date time.Time) (models.Entry, error) {
+// NewEntry describes a finished time entry to create
+type NewEntry struct {
+	ProjectID   string
+	TaskID      string
+	Description string
+	TagIDs      []string
+	Billable    bool
+	Range       TimeRange
+}
 
-	// Parse the time range string (e.g., "9a - 5p") into actual times
-	startTime, _ := utils.ParseTime(startTimeStr, date)
-	endTime, _ := utils.ParseTime(endTimeStr, date)
+// CreateTimeEntry creates a new time entry in Clockify
+// An invalid range is rejected before anything is sent
+func (c *Client) CreateTimeEntry(ctx context.Context, workspaceID string, entry NewEntry) (models.Entry, error) {
+	if err := entry.Range.Validate(); err != nil {
+		return models.Entry{}, fmt.Errorf("invalid time entry: %w", err)
+	}
 
 	// Build the request payload
-	entry := models.TimeEntryRequest{
-		Start:       startTime.Format(time.RFC3339), // Convert to RFC3339 format
-		End:         endTime.Format(time.RFC3339),
-		ProjectID:   projectID,
-		TaskID:      taskID,
-		Description: description,
+	request := models.TimeEntryRequest{
+		Start:       entry.Range.Start.Format(time.RFC3339), // Convert to RFC3339 format
+		End:         entry.Range.End.Format(time.RFC3339),
+		ProjectID:   entry.ProjectID,
+		TaskID:      entry.TaskID,
+		Description: entry.Description,
+		TagIDs:      entry.TagIDs,
+		Billable:    &entry.Billable,
 	}
 
 	// Build endpoint and make POST request
 	endpoint := fmt.Sprintf("/workspaces/%s/time-entries", workspaceID)
-	bytes, err := c.Post(endpoint, entry)
+	bytes, err := c.Post(ctx, endpoint, request)
 
 	if err != nil {
 		return models.Entry{}, fmt.Errorf("failed to create time entry: %w", err)
@@ -144,24 +201,67 @@ func (c *Client) CreateTimeEntry(workspaceID, projectID, taskID, description, st
 	return newEntry, nil
 }
 
-func (c *Client) UpdateTimeEntry(workspaceID, entryID, projectID, taskID, description, startTimeStr, endTimeStr string, date time.Time) (models.Entry, error) {
-	// Parse the time range string (e.g., "9a - 5p") into actual times
-	startTime, _ := utils.ParseTime(startTimeStr, date)
-	endTime, _ := utils.ParseTime(endTimeStr, date)
+// EntryChanges lists the fields an update modifies
+// Nil fields keep the entry's current value. TagIDs is also nil when unchanged,
+// an empty slice removes every tag.
+type EntryChanges struct {
+	Description *string
+	ProjectID   *string
+	TaskID      *string // Empty string removes the task
+	TagIDs      []string
+	Billable    *bool
+	Start       *time.Time
+	End         *time.Time
+}
 
-	// Build the request payload
-	entry := models.TimeEntryRequest{
-		Start:       startTime.Format(time.RFC3339), // Convert to RFC3339 format
-		End:         endTime.Format(time.RFC3339),
-		ProjectID:   projectID,
-		TaskID:      taskID,
-		Description: description,
+// readOnlyEntryFields are returned by Clockify but not accepted by an update
+var readOnlyEntryFields = []string{
+	"id",
+	"userId",
+	"workspaceId",
+	"timeInterval",
+	"customFieldValues",
+	"isLocked",
+	"hourlyRate",
+	"costRate",
+}
+
+// UpdateTimeEntry applies changes to an entry
+// Clockify's PUT replaces the whole entry, so the current entry is fetched and sent back
+// with only the changed fields replaced. Fields the app doesn't model, like the entry type
+// or custom field values, survive the edit.
+// A changed start or end is validated against the rest of the entry before it is sent.
+func (c *Client) UpdateTimeEntry(ctx context.Context, workspaceID, entryID string, changes EntryChanges) (models.Entry, error) {
+	// Both ends known up front, so a bad range doesn't cost a request
+	if changes.Start != nil && changes.End != nil {
+		if err := (TimeRange{Start: *changes.Start, End: *changes.End}).Validate(); err != nil {
+			return models.Entry{}, fmt.Errorf("invalid time entry: %w", err)
+		}
 	}
 
-	// Build endpoint and make PUT request
 	endpoint := fmt.Sprintf("/workspaces/%s/time-entries/%s", workspaceID, entryID)
-	bytes, err := c.Put(endpoint, entry)
 
+	current, err := c.Get(ctx, endpoint)
+	if err != nil {
+		return models.Entry{}, fmt.Errorf("failed to load time entry: %w", err)
+	}
+
+	var existing models.Entry
+	if err := json.Unmarshal(current, &existing); err != nil {
+		return models.Entry{}, fmt.Errorf("failed to parse time entry: %w", err)
+	}
+	if r, ok := changedRange(existing, changes); ok {
+		if err := r.Validate(); err != nil {
+			return models.Entry{}, fmt.Errorf("invalid time entry: %w", err)
+		}
+	}
+
+	body, err := mergeEntryUpdate(current, changes)
+	if err != nil {
+		return models.Entry{}, fmt.Errorf("failed to parse time entry: %w", err)
+	}
+
+	bytes, err := c.Put(ctx, endpoint, body)
 	if err != nil {
 		return models.Entry{}, fmt.Errorf("failed to update time entry: %w", err)
 	}
@@ -174,10 +274,108 @@ func (c *Client) UpdateTimeEntry(workspaceID, entryID, projectID, taskID, descri
 	return updatedEntry, nil
 }
 
-func (c *Client) DeleteTimeEntry(workspaceID, entryID string) error {
+// changedRange returns the interval an entry will have after changes,
+// false when the interval isn't changed or the entry is still running
+func changedRange(entry models.Entry, changes EntryChanges) (TimeRange, bool) {
+	if changes.Start == nil && changes.End == nil {
+		return TimeRange{}, false
+	}
+
+	r := TimeRange{Start: entry.TimeInterval.Start, End: entry.TimeInterval.End}
+	if changes.Start != nil {
+		r.Start = *changes.Start
+	}
+	if changes.End != nil {
+		r.End = *changes.End
+	}
+	if r.End.IsZero() {
+		return TimeRange{}, false
+	}
+	return r, true
+}
+
+// mergeEntryUpdate turns an entry as returned by Clockify into an update body with changes applied
+// Unknown fields are copied through untouched
+func mergeEntryUpdate(current []byte, changes EntryChanges) (map[string]any, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(current, &raw); err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]any, len(raw))
+	for key, value := range raw {
+		body[key] = value
+	}
+	for _, key := range readOnlyEntryFields {
+		delete(body, key)
+	}
+
+	// The interval is read as timeInterval but written as start and end
+	var interval struct {
+		Start json.RawMessage `json:"start"`
+		End   json.RawMessage `json:"end"`
+	}
+	if data, ok := raw["timeInterval"]; ok {
+		if err := json.Unmarshal(data, &interval); err != nil {
+			return nil, err
+		}
+	}
+	if len(interval.Start) > 0 {
+		body["start"] = interval.Start
+	}
+	if len(interval.End) > 0 && string(interval.End) != "null" {
+		body["end"] = interval.End
+	}
+
+	// Custom field values are read with their definitions but written as id and value pairs
+	if data, ok := raw["customFieldValues"]; ok && string(data) != "null" {
+		var values []struct {
+			CustomFieldID string          `json:"customFieldId"`
+			Value         json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, err
+		}
+
+		fields := make([]map[string]any, 0, len(values))
+		for _, value := range values {
+			fields = append(fields, map[string]any{
+				"customFieldId": value.CustomFieldID,
+				"value":         value.Value,
+			})
+		}
+		body["customFields"] = fields
+	}
+
+	if changes.Description != nil {
+		body["description"] = *changes.Description
+	}
+	if changes.ProjectID != nil {
+		body["projectId"] = *changes.ProjectID
+	}
+	if changes.TaskID != nil {
+		body["taskId"] = *changes.TaskID
+	}
+	if changes.TagIDs != nil {
+		body["tagIds"] = changes.TagIDs
+	}
+	if changes.Billable != nil {
+		body["billable"] = *changes.Billable
+	}
+	if changes.Start != nil {
+		body["start"] = changes.Start.Format(time.RFC3339)
+	}
+	if changes.End != nil {
+		body["end"] = changes.End.Format(time.RFC3339)
+	}
+
+	return body, nil
+}
+
+func (c *Client) DeleteTimeEntry(ctx context.Context, workspaceID, entryID string) error {
 	// Build endpoint and make DELETE request
 	endpoint := fmt.Sprintf("/workspaces/%s/time-entries/%s", workspaceID, entryID)
-	_, err := c.Delete(endpoint)
+	_, err := c.Delete(ctx, endpoint)
 
 	if err != nil {
 		return fmt.Errorf("failed to delete time entry: %w", err)
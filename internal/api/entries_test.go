@@ -1,10 +1,338 @@
 package api
 
 import (
+	"clockify-app/internal/cache"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
+func testEntries(n int) []models.Entry {
+	entries := make([]models.Entry, n)
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	for i := range entries {
+		entries[i] = models.Entry{
+			ID: fmt.Sprintf("e%d", i+1),
+			TimeInterval: models.IntervalTime{
+				Start: start.Add(time.Duration(i) * time.Hour),
+				End:   start.Add(time.Duration(i)*time.Hour + 30*time.Minute),
+			},
+		}
+	}
+	return entries
+}
+
 func TestCreateTimeEntry(t *testing.T) {
-	// Skip this test since it requires mocking the HTTP client
-	t.Skip("Skipping integration test - would require API client refactoring for proper mocking")
+	var sent models.TimeEntryRequest
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/workspaces/ws1/time-entries" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"e1","description":"Build"}`))
+	}))
+
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	entry, err := client.CreateTimeEntry(t.Context(), "ws1", NewEntry{
+		ProjectID:   "p1",
+		TaskID:      "t1",
+		Description: "Build",
+		TagIDs:      []string{"tag1"},
+		Billable:    true,
+		Range:       TimeRange{Start: date.Add(9 * time.Hour), End: date.Add(10*time.Hour + 30*time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if entry.ID != "e1" {
+		t.Errorf("Expected entry ID 'e1', got %q", entry.ID)
+	}
+	if sent.ProjectID != "p1" || sent.TaskID != "t1" || sent.Description != "Build" {
+		t.Errorf("Unexpected payload %+v", sent)
+	}
+	if len(sent.TagIDs) != 1 || sent.TagIDs[0] != "tag1" {
+		t.Errorf("Expected tag IDs to be sent, got %v", sent.TagIDs)
+	}
+	if sent.Billable == nil || !*sent.Billable {
+		t.Error("Expected billable to be sent")
+	}
+	if sent.Start != time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local).Format(time.RFC3339) {
+		t.Errorf("Unexpected start %q", sent.Start)
+	}
+}
+
+func TestCreateTimeEntryRejectsInvalidRange(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	_, err := client.CreateTimeEntry(t.Context(), "ws1", NewEntry{
+		Description: "Backwards",
+		Range:       TimeRange{Start: date.Add(11 * time.Hour), End: date.Add(9 * time.Hour)},
+	})
+	if !errors.Is(err, ErrEndBeforeStart) || !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected ErrEndBeforeStart matching ErrValidation, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no request for an invalid range, got %d", requests)
+	}
+}
+
+func TestUpdateTimeEntry(t *testing.T) {
+	current := `{
+		"id": "e1",
+		"userId": "u1",
+		"workspaceId": "ws1",
+		"description": "Build",
+		"projectId": "p1",
+		"taskId": "t1",
+		"tagIds": ["tag1"],
+		"billable": true,
+		"isLocked": false,
+		"kioskId": "k1",
+		"timeInterval": {"start": "2026-10-12T09:00:00Z", "end": null, "duration": null}
+	}`
+
+	var methods []string
+	var sent map[string]any
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.URL.Path != "/workspaces/ws1/time-entries/e1" {
+			t.Errorf("Unexpected path %q", r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		}
+		_, _ = w.Write([]byte(current))
+	}))
+
+	projectID := "p2"
+	if _, err := client.UpdateTimeEntry(t.Context(), "ws1", "e1", EntryChanges{ProjectID: &projectID}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(methods) != 2 || methods[0] != http.MethodGet || methods[1] != http.MethodPut {
+		t.Fatalf("Expected GET then PUT, got %v", methods)
+	}
+	if sent["projectId"] != "p2" {
+		t.Errorf("Expected the project to change, got %v", sent["projectId"])
+	}
+	if sent["description"] != "Build" || sent["taskId"] != "t1" || sent["billable"] != true {
+		t.Errorf("Untouched fields should be sent back, got %v", sent)
+	}
+	if sent["kioskId"] != "k1" {
+		t.Error("Unknown fields should be sent back")
+	}
+	if sent["start"] != "2026-10-12T09:00:00Z" {
+		t.Errorf("Expected start from the interval, got %v", sent["start"])
+	}
+	if _, ok := sent["end"]; ok {
+		t.Error("A running entry should stay running")
+	}
+	for _, key := range []string{"id", "userId", "timeInterval", "isLocked"} {
+		if _, ok := sent[key]; ok {
+			t.Errorf("Read-only field %q should not be sent", key)
+		}
+	}
+}
+
+func TestUpdateTimeEntryRejectsInvalidRange(t *testing.T) {
+	var methods []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{"id":"e1","timeInterval":{"start":"2026-10-12T09:00:00Z","end":"2026-10-12T10:00:00Z"}}`))
+	}))
+
+	// Only the end is changed, so it is checked against the stored start
+	end := time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC)
+	_, err := client.UpdateTimeEntry(t.Context(), "ws1", "e1", EntryChanges{End: &end})
+	if !errors.Is(err, ErrEndBeforeStart) {
+		t.Errorf("Expected ErrEndBeforeStart, got %v", err)
+	}
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Errorf("Expected only the GET, got %v", methods)
+	}
+
+	// Both ends are checked before anything is fetched
+	methods = nil
+	start := end.Add(-25 * time.Hour)
+	_, err = client.UpdateTimeEntry(t.Context(), "ws1", "e1", EntryChanges{Start: &start, End: &end})
+	if !errors.Is(err, ErrTooLong) {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+	if len(methods) != 0 {
+		t.Errorf("Expected no requests, got %v", methods)
+	}
+}
+
+func TestDeleteTimeEntry(t *testing.T) {
+	var method, path string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	if err := client.DeleteTimeEntry(t.Context(), "ws1", "e1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if method != http.MethodDelete || path != "/workspaces/ws1/time-entries/e1" {
+		t.Errorf("Unexpected request %s %s", method, path)
+	}
+}
+
+func TestGetEntries(t *testing.T) {
+	originalLimit := RecentEntriesLimit
+	RecentEntriesLimit = 5
+	defer func() { RecentEntriesLimit = originalLimit }()
+
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, testEntries(12), &requests))
+	client.SetPageSize(2)
+
+	entries, err := client.GetEntries(t.Context(), "ws1", "u1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Only the most recent entries are returned
+	if len(entries) != 5 {
+		t.Errorf("Expected 5 entries, got %d", len(entries))
+	}
+	if len(requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(requests))
+	}
+}
+
+func TestGetEntries_PageLimitIsNotAnError(t *testing.T) {
+	client := newTestClient(t, pagedHandler(t, testEntries(12), nil))
+	client.SetPageSize(2)
+	client.SetMaxPages(2)
+
+	entries, err := client.GetEntries(t.Context(), "ws1", "u1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 4 {
+		t.Errorf("Expected 4 entries, got %d", len(entries))
+	}
+}
+
+func TestGetEntriesInRange(t *testing.T) {
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, testEntries(7), &requests))
+	client.SetPageSize(3)
+
+	start := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+
+	entries, err := client.GetEntriesInRange(t.Context(), "ws1", "u1", start, end)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(entries) != 7 {
+		t.Errorf("Expected all 7 entries across pages, got %d", len(entries))
+	}
+
+	query := requests[0].URL.Query()
+	if query.Get("start") != "2026-10-11T00:00:00Z" {
+		t.Errorf("Unexpected start param %q", query.Get("start"))
+	}
+	if query.Get("end") != "2026-10-18T00:00:00Z" {
+		t.Errorf("Unexpected end param %q", query.Get("end"))
+	}
+}
+
+func TestFetchEntriesForRange(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(pagedHandler(t, testEntries(2), &requests))
+	defer server.Close()
+
+	Configure(WithBaseURL(server.URL))
+	defer Configure()
+
+	start, end := WeekRange(time.Date(2026, 10, 11, 15, 0, 0, 0, time.Local))
+
+	msg := FetchEntriesForRange(t.Context(), "key", "ws1", "u1", start, end)()
+	loaded, ok := msg.(messages.EntriesLoadedMsg)
+	if !ok {
+		t.Fatalf("Expected EntriesLoadedMsg, got %T", msg)
+	}
+	if !loaded.ForRange(start, end) || len(loaded.Entries) != 2 {
+		t.Errorf("Expected 2 entries tagged with the range, got %+v", loaded)
+	}
+
+	// A cancelled fetch reports nothing
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if msg := FetchEntriesForRange(ctx, "key", "ws1", "u1", start, end)(); msg != nil {
+		t.Errorf("Expected no message for a cancelled fetch, got %T", msg)
+	}
+}
+
+func TestFetchDayEntries(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(pagedHandler(t, testEntries(2), &requests))
+	defer server.Close()
+
+	Configure(WithBaseURL(server.URL))
+	defer Configure()
+
+	c := cache.GetInstance()
+	c.InvalidateEntries()
+	defer c.InvalidateEntries()
+
+	day := time.Date(2026, 10, 12, 15, 0, 0, 0, time.Local)
+	start, end := DayRange(day)
+	if end.Sub(start) != 48*time.Hour {
+		t.Errorf("Expected the day and the one before it, got %v to %v", start, end)
+	}
+
+	msg := FetchDayEntries("key", "ws1", "u1", day)()
+	loaded, ok := msg.(messages.DayEntriesLoadedMsg)
+	if !ok || len(loaded.Entries) != 2 || len(requests) != 1 {
+		t.Fatalf("Expected 2 fetched entries, got %+v after %d requests", msg, len(requests))
+	}
+
+	// Cached entries that reach back far enough are used as they are
+	c.SetEntries([]models.Entry{
+		{ID: "recent", TimeInterval: models.IntervalTime{Start: day}},
+		{ID: "old", TimeInterval: models.IntervalTime{Start: start.Add(-time.Hour)}},
+	})
+	loaded, _ = FetchDayEntries("key", "ws1", "u1", day)().(messages.DayEntriesLoadedMsg)
+	if len(loaded.Entries) != 2 || loaded.Entries[0].ID != "recent" || len(requests) != 1 {
+		t.Errorf("Expected the cached entries without a request, got %+v", loaded.Entries)
+	}
+}
+
+func TestWeekAndMonthRange(t *testing.T) {
+	// Both start a day early to catch entries running overnight into the range
+	weekStart := time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local)
+	start, end := WeekRange(time.Date(2026, 10, 11, 23, 30, 0, 0, time.Local))
+	if !start.Equal(weekStart.Add(-MaxEntryDuration)) || !end.Equal(weekStart.AddDate(0, 0, 7)) {
+		t.Errorf("Unexpected week range %v - %v", start, end)
+	}
+
+	monthStart := time.Date(2026, 12, 1, 0, 0, 0, 0, time.Local)
+	start, end = MonthRange(time.Date(2026, 12, 17, 0, 0, 0, 0, time.Local))
+	if !start.Equal(monthStart.Add(-MaxEntryDuration)) || !end.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Unexpected month range %v - %v", start, end)
+	}
+
+	// The range follows the local date, not the UTC one
+	late := time.Date(2026, 10, 17, 23, 30, 0, 0, time.Local)
+	start, _ = WeekRange(late.UTC())
+	if !start.Equal(time.Date(2026, 10, 17, 0, 0, 0, 0, time.Local).Add(-MaxEntryDuration)) {
+		t.Errorf("Expected the week of the local date, got %v", start)
+	}
 }
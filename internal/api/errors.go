@@ -0,0 +1,83 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched with errors.Is against an *APIError
+var (
+	ErrUnauthorized = errors.New("invalid or missing API key")
+	ErrForbidden    = errors.New("access denied")
+	ErrNotFound     = errors.New("not found")
+	ErrValidation   = errors.New("request rejected by validation")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+// APIError is a non-2xx response from Clockify
+type APIError struct {
+	StatusCode int    // HTTP status
+	Code       int    // Clockify's error code from the body, zero when absent
+	Message    string // Clockify's message, or the status text when the body has none
+}
+
+// newAPIError builds an APIError from a response, parsing Clockify's
+// {"message": "...", "code": 123} body when there is one
+func newAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: status}
+
+	var parsed struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Code = parsed.Code
+		apiErr.Message = parsed.Message
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(status)
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Code != 0 {
+		return fmt.Sprintf("API error (status %d, code %d): %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// Is maps the status code onto the sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// ErrorMessage returns Clockify's own message for API errors, or err's text otherwise
+// Use it for showing errors to the user without the status prefix
+func ErrorMessage(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Message
+	}
+	return err.Error()
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		wantCode int
+		wantMsg  string
+	}{
+		{"clockify body", 400, `{"message":"End time must be after start time","code":501}`, 501, "End time must be after start time"},
+		{"plain text body", 502, "Bad Gateway from proxy\n", 0, "Bad Gateway from proxy"},
+		{"empty body", 404, "", 0, "Not Found"},
+		{"json without message", 500, `{"code":12}`, 12, `{"code":12}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newAPIError(tt.status, []byte(tt.body))
+			if err.StatusCode != tt.status || err.Code != tt.wantCode || err.Message != tt.wantMsg {
+				t.Errorf("newAPIError() = %+v, want code %d and message %q", err, tt.wantCode, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrValidation, ErrRateLimited}
+
+	for _, tt := range tests {
+		// Errors are usually wrapped by the endpoint methods
+		err := fmt.Errorf("failed to do thing: %w", &APIError{StatusCode: tt.status})
+
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+				t.Errorf("status %d: errors.Is(%v) = %v", tt.status, sentinel, got)
+			}
+		}
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	wrapped := fmt.Errorf("failed to create time entry: %w", &APIError{StatusCode: 400, Message: "Project is archived"})
+	if got := ErrorMessage(wrapped); got != "Project is archived" {
+		t.Errorf("Expected Clockify's message, got %q", got)
+	}
+
+	if got := ErrorMessage(errors.New("request failed")); got != "request failed" {
+		t.Errorf("Expected the plain error text, got %q", got)
+	}
+}
@@ -0,0 +1,257 @@
+// Package fakeclockify implements an in-memory Clockify API for tests and offline development.
+// It serves the subset of endpoints the app uses, with Clockify's pagination,
+// error body and rate-limit behaviour, so api.Client can be exercised end to end.
+package fakeclockify
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Defaults seeded into every new server
+const (
+	DefaultAPIKey      = "fake-api-key"
+	DefaultUserID      = "fake-user"
+	DefaultWorkspaceID = "fake-workspace"
+
+	// Clockify uses 50 as the page size when none is requested
+	defaultPageSize = 50
+)
+
+// Request is a request the server received, recorded for assertions
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+}
+
+// Failure is an error response injected with FailNext
+type Failure struct {
+	Status  int
+	Code    int
+	Message string
+}
+
+// Server is an in-memory Clockify API
+// The zero value is not usable, create one with New
+type Server struct {
+	// APIKey is the key requests must send in X-Api-Key, set it before starting
+	APIKey string
+
+	// URL is the base URL of the running server, empty until started
+	URL string
+
+	mu         sync.Mutex
+	httpServer *httptest.Server
+	mux        *http.ServeMux
+	nextID     int
+
+	user       models.User
+	workspaces []models.Workspace
+	projects   map[string][]models.Project // by workspace
+	tasks      map[string][]models.Task    // by project
+	tags       map[string][]models.Tag     // by workspace
+	entries    map[string][]*timeEntry     // by workspace
+
+	failures []Failure
+	requests []Request
+
+	// Fixed-window rate limiting, disabled when rateLimit is zero
+	rateLimit   int
+	rateWindow  time.Duration
+	windowStart time.Time
+	windowCount int
+}
+
+// New returns a server seeded with one user and one workspace
+// Call Start to serve it over HTTP, or use it directly as an http.Handler
+func New() *Server {
+	s := &Server{
+		APIKey: DefaultAPIKey,
+		user: models.User{
+			ID:       DefaultUserID,
+			Username: "Fake User",
+			Email:    "fake.user@example.com",
+			Status:   "ACTIVE",
+		},
+		workspaces: []models.Workspace{{ID: DefaultWorkspaceID, Name: "Fake Workspace"}},
+		projects:   make(map[string][]models.Project),
+		tasks:      make(map[string][]models.Task),
+		tags:       make(map[string][]models.Tag),
+		entries:    make(map[string][]*timeEntry),
+	}
+	s.routes()
+	return s
+}
+
+// Start serves the fake on a random local port
+func (s *Server) Start() *Server {
+	s.httpServer = httptest.NewServer(s)
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// StartAt serves the fake on the given address, e.g. "127.0.0.1:8787"
+func (s *Server) StartAt(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	s.httpServer = httptest.NewUnstartedServer(s)
+	s.httpServer.Listener.Close()
+	s.httpServer.Listener = listener
+	s.httpServer.Start()
+	s.URL = s.httpServer.URL
+	return nil
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	if s.httpServer != nil {
+		s.httpServer.Close()
+	}
+}
+
+// FailNext makes the next request fail with the given status and Clockify error body
+// Calls queue up, one failure per request
+func (s *Server) FailNext(status, code int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, Failure{Status: status, Code: code, Message: message})
+}
+
+// SetRateLimit allows at most limit requests per window and answers the rest with 429
+// A zero limit disables rate limiting
+func (s *Server) SetRateLimit(limit int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = limit
+	s.rateWindow = window
+	s.windowStart = time.Time{}
+	s.windowCount = 0
+}
+
+// Requests returns every request received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// ServeHTTP authenticates the request, applies injected failures and rate limits,
+// then dispatches to the endpoint handlers
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Accept both bare paths and the real /api/v1 prefix
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, "/api/v1")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery})
+
+	if retryAfter, limited := s.rateLimited(); limited {
+		s.mu.Unlock()
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		writeError(w, http.StatusTooManyRequests, 429, "Too many requests")
+		return
+	}
+
+	if len(s.failures) > 0 {
+		failure := s.failures[0]
+		s.failures = s.failures[1:]
+		s.mu.Unlock()
+		writeError(w, failure.Status, failure.Code, failure.Message)
+		return
+	}
+	s.mu.Unlock()
+
+	if r.Header.Get("X-Api-Key") != s.APIKey {
+		writeError(w, http.StatusUnauthorized, 1000, "Full authentication is required to access this resource")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// rateLimited counts the request against the current window
+// Must be called with s.mu held
+func (s *Server) rateLimited() (int, bool) {
+	if s.rateLimit <= 0 {
+		return 0, false
+	}
+
+	now := time.Now()
+	if now.Sub(s.windowStart) >= s.rateWindow {
+		s.windowStart = now
+		s.windowCount = 0
+	}
+
+	s.windowCount++
+	if s.windowCount <= s.rateLimit {
+		return 0, false
+	}
+
+	// Retry-After is whole seconds, round up so clients never retry too early
+	remaining := s.rateWindow - now.Sub(s.windowStart)
+	return int((remaining + time.Second - 1) / time.Second), true
+}
+
+// newID returns a unique 24 character hex ID, the same shape Clockify uses
+// Must be called with s.mu held
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%024x", s.nextID)
+}
+
+// errorBody is the JSON body Clockify sends with error responses
+type errorBody struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeJSON(w, status, errorBody{Message: message, Code: code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// paginate applies Clockify's page/page-size query parameters to items
+func paginate[T any](r *http.Request, items []T) ([]T, error) {
+	page, pageSize := 1, defaultPageSize
+
+	if v := r.URL.Query().Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid page %q", v)
+		}
+		page = n
+	}
+	if v := r.URL.Query().Get("page-size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid page-size %q", v)
+		}
+		pageSize = n
+	}
+
+	start := (page - 1) * pageSize
+	if start >= len(items) {
+		return []T{}, nil
+	}
+	end := min(start+pageSize, len(items))
+	return items[start:end], nil
+}
@@ -0,0 +1,301 @@
+package fakeclockify
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newServer(t *testing.T) *Server {
+	t.Helper()
+
+	s := New().Start()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// call sends an authenticated request and decodes the JSON response into T
+func call[T any](t *testing.T, s *Server, method, path, body string) (*http.Response, T) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("X-Api-Key", s.APIKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var v T
+	_ = json.NewDecoder(resp.Body).Decode(&v)
+	return resp, v
+}
+
+func TestUnauthorized(t *testing.T) {
+	s := newServer(t)
+
+	req, _ := http.NewRequest("GET", s.URL+"/user", nil)
+	req.Header.Set("X-Api-Key", "wrong-key")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body errorBody
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401, got %d", resp.StatusCode)
+	}
+	if body.Code != 1000 || body.Message == "" {
+		t.Errorf("Expected Clockify error body, got %+v", body)
+	}
+}
+
+func TestUserAndWorkspaces(t *testing.T) {
+	s := newServer(t)
+
+	_, user := call[models.User](t, s, "GET", "/user", "")
+	if user.ID != DefaultUserID {
+		t.Errorf("Expected user %q, got %q", DefaultUserID, user.ID)
+	}
+
+	// The real /api/v1 prefix is accepted too
+	_, workspaces := call[[]models.Workspace](t, s, "GET", "/api/v1/workspaces", "")
+	if len(workspaces) != 1 || workspaces[0].ID != DefaultWorkspaceID {
+		t.Errorf("Expected the default workspace, got %+v", workspaces)
+	}
+}
+
+func TestProjectsPagination(t *testing.T) {
+	s := newServer(t)
+	for range 5 {
+		s.AddProject(models.Project{Name: "Project"})
+	}
+	s.AddProject(models.Project{Name: "Old", IsArchived: true})
+
+	path := "/workspaces/" + DefaultWorkspaceID + "/projects?archived=false&page-size=2"
+	tests := []struct {
+		page string
+		want int
+	}{
+		{"1", 2},
+		{"2", 2},
+		{"3", 1},
+		{"4", 0},
+	}
+
+	for _, tt := range tests {
+		resp, projects := call[[]models.Project](t, s, "GET", path+"&page="+tt.page, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Page %s: expected 200, got %d", tt.page, resp.StatusCode)
+		}
+		if len(projects) != tt.want {
+			t.Errorf("Page %s: expected %d projects, got %d", tt.page, tt.want, len(projects))
+		}
+	}
+
+	resp, _ := call[errorBody](t, s, "GET", path+"&page=0", "")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an invalid page, got %d", resp.StatusCode)
+	}
+}
+
+func TestTasksFilterActive(t *testing.T) {
+	s := newServer(t)
+	project := s.AddProject(models.Project{Name: "Website"})
+	s.AddTask(models.Task{Name: "Design", ProjectID: project.ID})
+	s.AddTask(models.Task{Name: "Done", ProjectID: project.ID, Status: "DONE"})
+
+	_, tasks := call[[]models.Task](t, s, "GET", "/workspaces/"+DefaultWorkspaceID+"/projects/"+project.ID+"/tasks?is-active=true", "")
+	if len(tasks) != 1 || tasks[0].Name != "Design" {
+		t.Errorf("Expected only the active task, got %+v", tasks)
+	}
+
+	resp, _ := call[errorBody](t, s, "GET", "/workspaces/"+DefaultWorkspaceID+"/projects/missing/tasks", "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown project, got %d", resp.StatusCode)
+	}
+}
+
+func TestTags(t *testing.T) {
+	s := newServer(t)
+	path := "/workspaces/" + DefaultWorkspaceID + "/tags"
+
+	resp, tag := call[models.Tag](t, s, "POST", path, `{"name":"Meeting"}`)
+	if resp.StatusCode != http.StatusCreated || tag.ID == "" {
+		t.Fatalf("Expected created tag, got %d %+v", resp.StatusCode, tag)
+	}
+
+	resp, _ = call[errorBody](t, s, "POST", path, `{"name":"meeting"}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a duplicate tag, got %d", resp.StatusCode)
+	}
+
+	_, tags := call[[]models.Tag](t, s, "GET", path, "")
+	if len(tags) != 1 {
+		t.Errorf("Expected 1 tag, got %d", len(tags))
+	}
+}
+
+func TestTimeEntryLifecycle(t *testing.T) {
+	s := newServer(t)
+	project := s.AddProject(models.Project{Name: "Website"})
+	base := "/workspaces/" + DefaultWorkspaceID
+
+	// Start a timer
+	resp, created := call[map[string]any](t, s, "POST", base+"/time-entries",
+		`{"start":"2026-10-12T09:00:00Z","projectId":"`+project.ID+`","description":"Build"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d", resp.StatusCode)
+	}
+	id := created["id"].(string)
+	if interval := created["timeInterval"].(map[string]any); interval["end"] != nil {
+		t.Errorf("Running entry should have a null end, got %v", interval["end"])
+	}
+
+	// A second timer is rejected while one runs
+	resp, _ = call[errorBody](t, s, "POST", base+"/time-entries", `{"start":"2026-10-12T09:30:00Z"}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a second timer, got %d", resp.StatusCode)
+	}
+
+	_, running := call[[]models.Entry](t, s, "GET", base+"/user/"+DefaultUserID+"/time-entries?in-progress=true", "")
+	if len(running) != 1 || running[0].ID != id {
+		t.Fatalf("Expected the running entry, got %+v", running)
+	}
+
+	// Stop it
+	resp, stopped := call[models.Entry](t, s, "PATCH", base+"/user/"+DefaultUserID+"/time-entries", `{"end":"2026-10-12T10:30:00Z"}`)
+	if resp.StatusCode != http.StatusOK || stopped.Duration() != 90*time.Minute {
+		t.Errorf("Expected a 90 minute entry, got %d %v", resp.StatusCode, stopped.Duration())
+	}
+	if stopped.TimeInterval.Duration != "PT1H30M" {
+		t.Errorf("Expected ISO duration PT1H30M, got %q", stopped.TimeInterval.Duration)
+	}
+
+	resp, _ = call[errorBody](t, s, "PATCH", base+"/user/"+DefaultUserID+"/time-entries", `{"end":"2026-10-12T11:00:00Z"}`)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 with no running timer, got %d", resp.StatusCode)
+	}
+
+	// Update replaces the entry, so omitted fields are reset
+	resp, updated := call[models.Entry](t, s, "PUT", base+"/time-entries/"+id,
+		`{"start":"2026-10-12T09:00:00Z","end":"2026-10-12T11:00:00Z","description":"Build v2"}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	if updated.Description != "Build v2" || updated.ProjectID != "" {
+		t.Errorf("Expected a full replacement, got %+v", updated)
+	}
+
+	resp, _ = call[errorBody](t, s, "PUT", base+"/time-entries/"+id,
+		`{"start":"2026-10-12T09:00:00Z","end":"2026-10-12T08:00:00Z"}`)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for end before start, got %d", resp.StatusCode)
+	}
+
+	// Delete
+	resp, _ = call[errorBody](t, s, "DELETE", base+"/time-entries/"+id, "")
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", resp.StatusCode)
+	}
+	resp, _ = call[errorBody](t, s, "GET", base+"/time-entries/"+id, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 after delete, got %d", resp.StatusCode)
+	}
+}
+
+func TestListEntriesRange(t *testing.T) {
+	s := newServer(t)
+	day := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	for i := range 3 {
+		start := day.AddDate(0, 0, i).Add(9 * time.Hour)
+		s.AddEntry(models.Entry{TimeInterval: models.IntervalTime{Start: start, End: start.Add(time.Hour)}})
+	}
+
+	path := "/workspaces/" + DefaultWorkspaceID + "/user/" + DefaultUserID + "/time-entries" +
+		"?start=2026-10-13T00:00:00Z&end=2026-10-15T00:00:00Z"
+	_, entries := call[[]models.Entry](t, s, "GET", path, "")
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries in range, got %d", len(entries))
+	}
+	if !entries[0].TimeInterval.Start.After(entries[1].TimeInterval.Start) {
+		t.Error("Entries should be listed newest first")
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	s := newServer(t)
+	s.FailNext(http.StatusInternalServerError, 500, "boom")
+
+	resp, body := call[errorBody](t, s, "GET", "/user", "")
+	if resp.StatusCode != http.StatusInternalServerError || body.Message != "boom" {
+		t.Errorf("Expected injected failure, got %d %+v", resp.StatusCode, body)
+	}
+
+	// Only the next request fails
+	if resp, _ := call[models.User](t, s, "GET", "/user", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 after the failure, got %d", resp.StatusCode)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	s := newServer(t)
+	s.SetRateLimit(2, time.Minute)
+
+	for range 2 {
+		if resp, _ := call[models.User](t, s, "GET", "/user", ""); resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected 200 within the limit, got %d", resp.StatusCode)
+		}
+	}
+
+	resp, _ := call[errorBody](t, s, "GET", "/user", "")
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429 over the limit, got %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("Expected a Retry-After header")
+	}
+
+	if got := len(s.Requests()); got != 3 {
+		t.Errorf("Expected 3 recorded requests, got %d", got)
+	}
+}
+
+func TestSeedDemoData(t *testing.T) {
+	s := New()
+	s.SeedDemoData(time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)) // a Friday
+
+	if got := len(s.Entries(DefaultWorkspaceID)); got != 20 {
+		t.Errorf("Expected 20 demo entries for five weekdays, got %d", got)
+	}
+}
+
+func TestISODuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{45 * time.Second, "PT45S"},
+		{time.Hour, "PT1H"},
+		{90 * time.Minute, "PT1H30M"},
+		{2*time.Hour + 5*time.Second, "PT2H5S"},
+	}
+
+	for _, tt := range tests {
+		if got := isoDuration(tt.d); got != tt.want {
+			t.Errorf("isoDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
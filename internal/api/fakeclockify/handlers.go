@@ -0,0 +1,549 @@
+package fakeclockify
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// timeInterval mirrors Clockify's wire format, where running entries have a null end
+type timeInterval struct {
+	Start    time.Time  `json:"start"`
+	End      *time.Time `json:"end"`
+	Duration *string    `json:"duration"`
+}
+
+// timeEntry is the stored form of an entry
+// It carries fields the app does not model so clients can be checked for clobbering them
+type timeEntry struct {
+	ID                string       `json:"id"`
+	Description       string       `json:"description"`
+	ProjectID         string       `json:"projectId"`
+	TaskID            *string      `json:"taskId"`
+	TagIDs            []string     `json:"tagIds"`
+	UserID            string       `json:"userId"`
+	WorkspaceID       string       `json:"workspaceId"`
+	Billable          bool         `json:"billable"`
+	Type              string       `json:"type"`
+	IsLocked          bool         `json:"isLocked"`
+	CustomFieldValues []any        `json:"customFieldValues"`
+	TimeInterval      timeInterval `json:"timeInterval"`
+}
+
+// entryRequest is the body accepted by create and update
+// Pointers tell apart fields that were omitted from ones sent as zero values
+type entryRequest struct {
+	Start        *time.Time `json:"start"`
+	End          *time.Time `json:"end"`
+	Description  string     `json:"description"`
+	ProjectID    string     `json:"projectId"`
+	TaskID       string     `json:"taskId"`
+	TagIDs       []string   `json:"tagIds"`
+	Billable     bool       `json:"billable"`
+	Type         string     `json:"type"`
+	CustomFields []any      `json:"customFields"`
+}
+
+type stopRequest struct {
+	End *time.Time `json:"end"`
+}
+
+func (s *Server) routes() {
+	s.mux = http.NewServeMux()
+
+	s.mux.HandleFunc("GET /user", s.handleUser)
+	s.mux.HandleFunc("GET /workspaces", s.handleWorkspaces)
+	s.mux.HandleFunc("GET /workspaces/{ws}/projects", s.handleProjects)
+	s.mux.HandleFunc("GET /workspaces/{ws}/projects/{project}", s.handleGetProject)
+	s.mux.HandleFunc("GET /workspaces/{ws}/projects/{project}/tasks", s.handleTasks)
+	s.mux.HandleFunc("GET /workspaces/{ws}/projects/{project}/tasks/{task}", s.handleGetTask)
+	s.mux.HandleFunc("GET /workspaces/{ws}/tags", s.handleTags)
+	s.mux.HandleFunc("POST /workspaces/{ws}/tags", s.handleCreateTag)
+	s.mux.HandleFunc("GET /workspaces/{ws}/user/{user}/time-entries", s.handleListEntries)
+	s.mux.HandleFunc("PATCH /workspaces/{ws}/user/{user}/time-entries", s.handleStopTimer)
+	s.mux.HandleFunc("POST /workspaces/{ws}/time-entries", s.handleCreateEntry)
+	s.mux.HandleFunc("GET /workspaces/{ws}/time-entries/{id}", s.handleGetEntry)
+	s.mux.HandleFunc("PUT /workspaces/{ws}/time-entries/{id}", s.handleUpdateEntry)
+	s.mux.HandleFunc("DELETE /workspaces/{ws}/time-entries/{id}", s.handleDeleteEntry)
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, 404, "No static resource "+r.URL.Path)
+	})
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.user)
+}
+
+func (s *Server) handleWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.workspaces)
+}
+
+func (s *Server) handleProjects(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	archived := r.URL.Query().Get("archived")
+	var projects []models.Project
+	for _, project := range s.projects[ws] {
+		if archived != "" && fmt.Sprint(project.IsArchived) != archived {
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	writePage(w, r, projects)
+}
+
+func (s *Server) handleGetProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	for _, project := range s.projects[ws] {
+		if project.ID == r.PathValue("project") {
+			writeJSON(w, http.StatusOK, project)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, 501, "Project doesn't belong to Workspace")
+}
+
+func (s *Server) handleTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	projectID := r.PathValue("project")
+	if !s.hasProject(ws, projectID) {
+		writeError(w, http.StatusNotFound, 501, "Project doesn't belong to Workspace")
+		return
+	}
+
+	active := r.URL.Query().Get("is-active")
+	var tasks []models.Task
+	for _, task := range s.tasks[projectID] {
+		if active != "" && fmt.Sprint(task.Status == "ACTIVE") != active {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+
+	writePage(w, r, tasks)
+}
+
+func (s *Server) handleGetTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	projectID := r.PathValue("project")
+	if !s.hasProject(ws, projectID) {
+		writeError(w, http.StatusNotFound, 501, "Project doesn't belong to Workspace")
+		return
+	}
+
+	for _, task := range s.tasks[projectID] {
+		if task.ID == r.PathValue("task") {
+			writeJSON(w, http.StatusOK, task)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, 501, "Task doesn't belong to Project")
+}
+
+func (s *Server) handleTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	archived := r.URL.Query().Get("archived")
+	var tags []models.Tag
+	for _, tag := range s.tags[ws] {
+		if archived != "" && fmt.Sprint(tag.Archived) != archived {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	writePage(w, r, tags)
+}
+
+func (s *Server) handleCreateTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.TagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, 3002, "Malformed request body")
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		writeError(w, http.StatusBadRequest, 501, "Tag name is required")
+		return
+	}
+	for _, tag := range s.tags[ws] {
+		if strings.EqualFold(tag.Name, req.Name) {
+			writeError(w, http.StatusBadRequest, 501, "Tag with name '"+req.Name+"' already exists")
+			return
+		}
+	}
+
+	tag := models.Tag{ID: s.newID(), Name: req.Name, WorkspaceID: ws}
+	s.tags[ws] = append(s.tags[ws], tag)
+	writeJSON(w, http.StatusCreated, tag)
+}
+
+func (s *Server) handleListEntries(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	var start, end time.Time
+	for name, dst := range map[string]*time.Time{"start": &start, "end": &end} {
+		if v := query.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, 501, "Invalid "+name+" parameter")
+				return
+			}
+			*dst = t
+		}
+	}
+	inProgress := query.Get("in-progress") == "true"
+
+	userID := r.PathValue("user")
+	var entries []*timeEntry
+	for _, entry := range s.entries[ws] {
+		switch {
+		case entry.UserID != userID:
+			continue
+		case inProgress && entry.TimeInterval.End != nil:
+			continue
+		case !start.IsZero() && entry.TimeInterval.Start.Before(start):
+			continue
+		case !end.IsZero() && !entry.TimeInterval.Start.Before(end):
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	// Clockify lists the newest entries first
+	slices.SortStableFunc(entries, func(a, b *timeEntry) int {
+		return b.TimeInterval.Start.Compare(a.TimeInterval.Start)
+	})
+
+	writePage(w, r, entries)
+}
+
+func (s *Server) handleStopTimer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	var req stopRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.End == nil {
+		writeError(w, http.StatusBadRequest, 501, "End time is required")
+		return
+	}
+
+	entry := s.runningEntry(ws, r.PathValue("user"))
+	if entry == nil {
+		writeError(w, http.StatusNotFound, 404, "Time entry doesn't exist")
+		return
+	}
+	if req.End.Before(entry.TimeInterval.Start) {
+		writeError(w, http.StatusBadRequest, 501, "End time must be after start time")
+		return
+	}
+
+	entry.setEnd(req.End)
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) handleCreateEntry(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := s.decodeEntry(w, r, ws)
+	if !ok {
+		return
+	}
+
+	// Only one timer can run at a time
+	if req.End == nil && s.runningEntry(ws, s.user.ID) != nil {
+		writeError(w, http.StatusBadRequest, 501, "You already have a timer running")
+		return
+	}
+
+	entry := &timeEntry{
+		ID:          s.newID(),
+		UserID:      s.user.ID,
+		WorkspaceID: ws,
+		Type:        "REGULAR",
+	}
+	entry.apply(req)
+	s.entries[ws] = append(s.entries[ws], entry)
+
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+func (s *Server) handleGetEntry(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	_, entry := s.findEntry(ws, r.PathValue("id"))
+	if entry == nil {
+		writeError(w, http.StatusNotFound, 404, "Time entry doesn't exist")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, entry)
+}
+
+// handleUpdateEntry replaces the entry like Clockify's PUT does:
+// fields missing from the body are reset rather than kept
+func (s *Server) handleUpdateEntry(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	_, entry := s.findEntry(ws, r.PathValue("id"))
+	if entry == nil {
+		writeError(w, http.StatusNotFound, 404, "Time entry doesn't exist")
+		return
+	}
+	if entry.IsLocked {
+		writeError(w, http.StatusForbidden, 501, "Time entry is locked")
+		return
+	}
+
+	req, ok := s.decodeEntry(w, r, ws)
+	if !ok {
+		return
+	}
+
+	entry.apply(req)
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) handleDeleteEntry(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	i, entry := s.findEntry(ws, r.PathValue("id"))
+	if entry == nil {
+		writeError(w, http.StatusNotFound, 404, "Time entry doesn't exist")
+		return
+	}
+
+	s.entries[ws] = slices.Delete(s.entries[ws], i, i+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// workspace returns the workspace ID from the path, writing a 403 for unknown ones
+// Must be called with s.mu held
+func (s *Server) workspace(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ws := r.PathValue("ws")
+	for _, workspace := range s.workspaces {
+		if workspace.ID == ws {
+			return ws, true
+		}
+	}
+
+	writeError(w, http.StatusForbidden, 1003, "Access denied to workspace "+ws)
+	return "", false
+}
+
+// decodeEntry parses and validates a create/update body
+// Must be called with s.mu held
+func (s *Server) decodeEntry(w http.ResponseWriter, r *http.Request, ws string) (entryRequest, bool) {
+	var req entryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, 3002, "Malformed request body")
+		return req, false
+	}
+
+	switch {
+	case req.Start == nil:
+		writeError(w, http.StatusBadRequest, 501, "Start time is required")
+	case req.End != nil && req.End.Before(*req.Start):
+		writeError(w, http.StatusBadRequest, 501, "End time must be after start time")
+	case req.ProjectID != "" && !s.hasProject(ws, req.ProjectID):
+		writeError(w, http.StatusBadRequest, 501, "Project doesn't belong to Workspace")
+	case req.TaskID != "" && !s.hasTask(req.ProjectID, req.TaskID):
+		writeError(w, http.StatusBadRequest, 501, "Task doesn't belong to Project")
+	default:
+		return req, true
+	}
+	return req, false
+}
+
+// runningEntry returns the user's in-progress entry, if any
+// Must be called with s.mu held
+func (s *Server) runningEntry(ws, userID string) *timeEntry {
+	for _, entry := range s.entries[ws] {
+		if entry.UserID == userID && entry.TimeInterval.End == nil {
+			return entry
+		}
+	}
+	return nil
+}
+
+// findEntry returns the index and entry with the given ID
+// Must be called with s.mu held
+func (s *Server) findEntry(ws, id string) (int, *timeEntry) {
+	for i, entry := range s.entries[ws] {
+		if entry.ID == id {
+			return i, entry
+		}
+	}
+	return -1, nil
+}
+
+// Must be called with s.mu held
+func (s *Server) hasProject(ws, projectID string) bool {
+	return slices.ContainsFunc(s.projects[ws], func(p models.Project) bool {
+		return p.ID == projectID
+	})
+}
+
+// Must be called with s.mu held
+func (s *Server) hasTask(projectID, taskID string) bool {
+	return slices.ContainsFunc(s.tasks[projectID], func(t models.Task) bool {
+		return t.ID == taskID
+	})
+}
+
+// apply overwrites the entry with a create/update request
+func (e *timeEntry) apply(req entryRequest) {
+	e.Description = req.Description
+	e.ProjectID = req.ProjectID
+	e.TaskID = nil
+	if req.TaskID != "" {
+		e.TaskID = &req.TaskID
+	}
+	e.TagIDs = req.TagIDs
+	if e.TagIDs == nil {
+		e.TagIDs = []string{}
+	}
+	e.Billable = req.Billable
+	if req.Type != "" {
+		e.Type = req.Type
+	}
+	e.CustomFieldValues = req.CustomFields
+	if e.CustomFieldValues == nil {
+		e.CustomFieldValues = []any{}
+	}
+	e.TimeInterval.Start = req.Start.UTC()
+	e.setEnd(req.End)
+}
+
+// setEnd closes or reopens the entry and keeps the ISO 8601 duration in sync
+func (e *timeEntry) setEnd(end *time.Time) {
+	if end == nil {
+		e.TimeInterval.End = nil
+		e.TimeInterval.Duration = nil
+		return
+	}
+
+	utc := end.UTC()
+	duration := isoDuration(utc.Sub(e.TimeInterval.Start))
+	e.TimeInterval.End = &utc
+	e.TimeInterval.Duration = &duration
+}
+
+// isoDuration formats d the way Clockify does, e.g. PT1H30M
+func isoDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d <= 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	b.WriteString("PT")
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+	}
+	if sec := d % time.Minute / time.Second; sec > 0 {
+		fmt.Fprintf(&b, "%dS", sec)
+	}
+	return b.String()
+}
+
+// writePage writes one page of items, or a 400 for bad paging parameters
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, err := paginate(r, items)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 501, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, page)
+}
@@ -0,0 +1,263 @@
+package fakeclockify
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"time"
+)
+
+// User returns the user the server authenticates every request as
+func (s *Server) User() models.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.user
+}
+
+// UseIdentity replaces the default API key, user and workspace, e.g. with the values
+// from a saved config so the app can talk to the fake without being reconfigured.
+// Call it before seeding data or starting the server. Empty values are left unchanged.
+func (s *Server) UseIdentity(apiKey, userID string, workspace models.Workspace) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if apiKey != "" {
+		s.APIKey = apiKey
+	}
+	if userID != "" {
+		s.user.ID = userID
+	}
+	if workspace.ID != "" {
+		if workspace.Name == "" {
+			workspace.Name = s.workspaces[0].Name
+		}
+		s.workspaces[0] = workspace
+	}
+}
+
+// AddWorkspace adds a workspace, generating an ID when empty
+func (s *Server) AddWorkspace(workspace models.Workspace) models.Workspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if workspace.ID == "" {
+		workspace.ID = s.newID()
+	}
+	s.workspaces = append(s.workspaces, workspace)
+	return workspace
+}
+
+// AddProject adds a project to its workspace, the first one when unset
+func (s *Server) AddProject(project models.Project) models.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if project.ID == "" {
+		project.ID = s.newID()
+	}
+	if project.WorkspaceID == "" {
+		project.WorkspaceID = s.workspaces[0].ID
+	}
+	s.projects[project.WorkspaceID] = append(s.projects[project.WorkspaceID], project)
+	return project
+}
+
+// AddTask adds a task to its project, active unless a status is given
+func (s *Server) AddTask(task models.Task) models.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if task.ID == "" {
+		task.ID = s.newID()
+	}
+	if task.Status == "" {
+		task.Status = "ACTIVE"
+	}
+	s.tasks[task.ProjectID] = append(s.tasks[task.ProjectID], task)
+	return task
+}
+
+// AddTag adds a tag to its workspace, the first one when unset
+func (s *Server) AddTag(tag models.Tag) models.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tag.ID == "" {
+		tag.ID = s.newID()
+	}
+	if tag.WorkspaceID == "" {
+		tag.WorkspaceID = s.workspaces[0].ID
+	}
+	s.tags[tag.WorkspaceID] = append(s.tags[tag.WorkspaceID], tag)
+	return tag
+}
+
+// AddEntry stores an entry as if it had been created through the API
+// A zero end makes it a running timer. Missing IDs default to the server's user and first workspace.
+func (s *Server) AddEntry(entry models.Entry) models.Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry.ID == "" {
+		entry.ID = s.newID()
+	}
+	if entry.WorkspaceID == "" {
+		entry.WorkspaceID = s.workspaces[0].ID
+	}
+	if entry.UserID == "" {
+		entry.UserID = s.user.ID
+	}
+
+	stored := &timeEntry{
+		ID:                entry.ID,
+		Description:       entry.Description,
+		ProjectID:         entry.ProjectID,
+		TagIDs:            append([]string{}, entry.TagIDs...),
+		UserID:            entry.UserID,
+		WorkspaceID:       entry.WorkspaceID,
+		Billable:          entry.Billable,
+		Type:              "REGULAR",
+		CustomFieldValues: []any{},
+	}
+	if entry.TaskID != "" {
+		stored.TaskID = &entry.TaskID
+	}
+	stored.TimeInterval.Start = entry.TimeInterval.Start.UTC()
+	if !entry.TimeInterval.End.IsZero() {
+		stored.setEnd(&entry.TimeInterval.End)
+	}
+
+	s.entries[entry.WorkspaceID] = append(s.entries[entry.WorkspaceID], stored)
+	return toModel(stored)
+}
+
+// SetEntryFields sets fields the app doesn't model, e.g. "type" or "customFieldValues",
+// on a stored entry. Unknown keys are ignored.
+func (s *Server) SetEntryFields(workspaceID, entryID string, fields map[string]any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, entry := s.findEntry(workspaceID, entryID)
+	if entry == nil {
+		return false
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, entry) == nil
+}
+
+// Entries returns the stored entries of a workspace in insertion order
+func (s *Server) Entries(workspaceID string) []models.Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make([]models.Entry, 0, len(s.entries[workspaceID]))
+	for _, entry := range s.entries[workspaceID] {
+		entries = append(entries, toModel(entry))
+	}
+	return entries
+}
+
+// RawEntry returns the stored entry as the JSON object the API would send
+func (s *Server) RawEntry(workspaceID, entryID string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, entry := s.findEntry(workspaceID, entryID)
+	if entry == nil {
+		return nil, false
+	}
+
+	data, _ := json.Marshal(entry)
+	var raw map[string]any
+	_ = json.Unmarshal(data, &raw)
+	return raw, true
+}
+
+// SeedDemoData fills the first workspace with a few projects, tasks, tags
+// and a week of entries ending today, for driving the TUI offline
+func (s *Server) SeedDemoData(now time.Time) {
+	website := s.AddProject(models.Project{Name: "Website Redesign", ClientName: "Acme Corp", Color: "#03A9F4", IsBillable: true})
+	mobile := s.AddProject(models.Project{Name: "Mobile App", ClientName: "Globex", Color: "#8BC34A", IsBillable: true})
+	internal := s.AddProject(models.Project{Name: "Internal", Color: "#FF9800"})
+	s.AddProject(models.Project{Name: "Legacy Migration", Color: "#9E9E9E", IsArchived: true})
+
+	design := s.AddTask(models.Task{Name: "Design", ProjectID: website.ID})
+	frontend := s.AddTask(models.Task{Name: "Frontend", ProjectID: website.ID})
+	s.AddTask(models.Task{Name: "Content", ProjectID: website.ID, Status: "DONE"})
+	api := s.AddTask(models.Task{Name: "API Integration", ProjectID: mobile.ID})
+	meetings := s.AddTask(models.Task{Name: "Meetings", ProjectID: internal.ID})
+
+	development := s.AddTag(models.Tag{Name: "Development"})
+	meeting := s.AddTag(models.Tag{Name: "Meeting"})
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	at := func(d time.Time, hour, minute int) time.Time {
+		return time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, d.Location())
+	}
+
+	for offset := 6; offset >= 0; offset-- {
+		d := day.AddDate(0, 0, -offset)
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			continue
+		}
+
+		s.AddEntry(models.Entry{
+			Description:  "Daily standup",
+			ProjectID:    internal.ID,
+			TaskID:       meetings.ID,
+			TagIDs:       []string{meeting.ID},
+			TimeInterval: models.IntervalTime{Start: at(d, 9, 0), End: at(d, 9, 15)},
+		})
+		s.AddEntry(models.Entry{
+			Description:  "Landing page layout",
+			ProjectID:    website.ID,
+			TaskID:       design.ID,
+			Billable:     true,
+			TagIDs:       []string{development.ID},
+			TimeInterval: models.IntervalTime{Start: at(d, 9, 30), End: at(d, 12, 0)},
+		})
+		s.AddEntry(models.Entry{
+			Description:  "Navigation components",
+			ProjectID:    website.ID,
+			TaskID:       frontend.ID,
+			Billable:     true,
+			TagIDs:       []string{development.ID},
+			TimeInterval: models.IntervalTime{Start: at(d, 13, 0), End: at(d, 15, 30)},
+		})
+		s.AddEntry(models.Entry{
+			Description:  "Auth endpoints",
+			ProjectID:    mobile.ID,
+			TaskID:       api.ID,
+			Billable:     true,
+			TimeInterval: models.IntervalTime{Start: at(d, 15, 45), End: at(d, 17, 30)},
+		})
+	}
+}
+
+// toModel converts a stored entry to the app's model
+func toModel(entry *timeEntry) models.Entry {
+	model := models.Entry{
+		ID:          entry.ID,
+		Description: entry.Description,
+		ProjectID:   entry.ProjectID,
+		WorkspaceID: entry.WorkspaceID,
+		UserID:      entry.UserID,
+		Billable:    entry.Billable,
+		TagIDs:      append([]string(nil), entry.TagIDs...),
+		TimeInterval: models.IntervalTime{
+			Start: entry.TimeInterval.Start,
+		},
+	}
+	if entry.TaskID != nil {
+		model.TaskID = *entry.TaskID
+	}
+	if entry.TimeInterval.End != nil {
+		model.TimeInterval.End = *entry.TimeInterval.End
+		model.TimeInterval.Duration = *entry.TimeInterval.Duration
+	}
+	return model
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// TimeOffPageSize is how many leave requests GetTimeOff asks for, enough for any range a view shows
+var TimeOffPageSize = 200
+
+// GetHolidays fetches the workspace holidays that apply to a user
+func (c *Client) GetHolidays(ctx context.Context, workspaceID, userID string) ([]models.Holiday, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/holidays?assigned-to=%s", workspaceID, url.QueryEscape(userID))
+	bytes, err := c.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch holidays: %w", err)
+	}
+
+	var holidays []models.Holiday
+	if err := json.Unmarshal(bytes, &holidays); err != nil {
+		return nil, fmt.Errorf("failed to parse holidays: %w", err)
+	}
+	return holidays, nil
+}
+
+// GetTimeOff fetches a user's approved leave overlapping [start, end)
+func (c *Client) GetTimeOff(ctx context.Context, workspaceID, userID string, start, end time.Time) ([]models.TimeOffRequest, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/time-off/requests", workspaceID)
+	body := map[string]any{
+		"page":     1,
+		"pageSize": TimeOffPageSize,
+		"start":    start.UTC().Format(time.RFC3339),
+		"end":      end.UTC().Format(time.RFC3339),
+		"statuses": []string{"APPROVED"},
+		"users":    []string{userID},
+	}
+
+	bytes, err := c.Post(ctx, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch time off: %w", err)
+	}
+
+	var page models.TimeOffRequests
+	if err := json.Unmarshal(bytes, &page); err != nil {
+		return nil, fmt.Errorf("failed to parse time off: %w", err)
+	}
+	return page.Requests, nil
+}
+
+// featureUnavailable reports whether Clockify turned a request down because the
+// workspace's plan doesn't include the feature, rather than because it failed
+func featureUnavailable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPaymentRequired {
+		return true
+	}
+	return errors.Is(err, ErrForbidden) || errors.Is(err, ErrNotFound)
+}
+
+// GetDaysOff collects the days off between the local dates of start and end:
+// the schedule's built-in holidays and holidays file, plus the workspace's
+// holidays and the user's approved time off when the plan includes them
+func (c *Client) GetDaysOff(ctx context.Context, workspaceID, userID string, schedule config.Schedule, start, end time.Time) ([]models.DayOff, error) {
+	days, err := holidays.Local(schedule, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	to := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.Local)
+
+	workspaceHolidays, err := c.GetHolidays(ctx, workspaceID, userID)
+	if err != nil && !featureUnavailable(err) {
+		return nil, err
+	}
+	for _, holiday := range workspaceHolidays {
+		days = append(days, holiday.DaysOff(from, to)...)
+	}
+
+	requests, err := c.GetTimeOff(ctx, workspaceID, userID, from, to)
+	if err != nil && !featureUnavailable(err) {
+		return nil, err
+	}
+	for _, request := range requests {
+		for _, day := range request.DaysOff() {
+			if !day.Date.Before(from) && day.Date.Before(to) {
+				days = append(days, day)
+			}
+		}
+	}
+
+	return days, nil
+}
+
+// FetchDaysOff returns a command that loads the days off in a view's range
+// The result is tagged with the range like FetchEntriesForRange's, and
+// cancelling ctx abandons the fetch without reporting an error.
+func FetchDaysOff(ctx context.Context, apiKey, workspaceId, userId string, schedule config.Schedule, start, end time.Time) tea.Cmd {
+	return func() tea.Msg {
+		client := NewClient(apiKey)
+		days, err := client.GetDaysOff(ctx, workspaceId, userId, schedule, start, end)
+
+		// A superseded fetch has nothing to report
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.DaysOffLoadedMsg{
+			Days:  days,
+			Start: start,
+			End:   end,
+		}
+	}
+}
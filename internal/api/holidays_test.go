@@ -0,0 +1,94 @@
+package api
+
+import (
+	"clockify-app/internal/config"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetDaysOff(t *testing.T) {
+	var timeOffBody map[string]any
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workspaces/ws1/holidays":
+			if r.URL.Query().Get("assigned-to") != "u1" {
+				t.Errorf("Expected holidays assigned to u1, got %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`[{"id":"h1","name":"Company Day","occursAnnually":true,
+				"datePeriod":{"startDate":"2020-12-24","endDate":"2020-12-24"}}]`))
+		case "/workspaces/ws1/time-off/requests":
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected time off to be searched with POST, got %s", r.Method)
+			}
+			_ = json.NewDecoder(r.Body).Decode(&timeOffBody)
+			_, _ = w.Write([]byte(`{"count":1,"requests":[{"id":"r1","policyName":"Vacation",
+				"status":{"statusType":"APPROVED"},
+				"timeOffPeriod":{"isHalfDay":false,"period":{
+					"start":"` + time.Date(2026, time.December, 28, 0, 0, 0, 0, time.Local).Format(time.RFC3339) + `",
+					"end":"` + time.Date(2027, time.January, 2, 0, 0, 0, 0, time.Local).Format(time.RFC3339) + `"}}}]}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+	}))
+
+	start := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.Local)
+	days, err := client.GetDaysOff(t.Context(), "ws1", "u1", config.Schedule{Country: "US"}, start, end)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	names := make(map[string]string)
+	for _, day := range days {
+		names[day.Date.Format("01/02")] = day.Name
+	}
+	want := map[string]string{
+		"12/24": "Company Day",
+		"12/25": "Christmas Day",
+		"12/28": "Vacation",
+		"12/31": "Vacation",
+	}
+	for date, name := range want {
+		if names[date] != name {
+			t.Errorf("Expected %s on %s, got %q", name, date, names[date])
+		}
+	}
+	if _, ok := names["01/01"]; ok {
+		t.Error("Leave past the end of the range should be left out")
+	}
+
+	if statuses, _ := timeOffBody["statuses"].([]any); len(statuses) != 1 || statuses[0] != "APPROVED" {
+		t.Errorf("Expected only approved time off, got %v", timeOffBody["statuses"])
+	}
+}
+
+func TestGetDaysOffWithoutPlan(t *testing.T) {
+	for _, status := range []int{http.StatusPaymentRequired, http.StatusForbidden, http.StatusNotFound} {
+		client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message":"feature not available","code":0}`))
+		}))
+
+		start := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.Local)
+		days, err := client.GetDaysOff(t.Context(), "ws1", "u1", config.Schedule{Country: "DE"}, start, start.AddDate(0, 1, 0))
+		if err != nil {
+			t.Fatalf("A %d should leave out Clockify's days off, got %v", status, err)
+		}
+		if len(days) != 2 {
+			t.Errorf("Expected both Christmas holidays from the built-in list after a %d, got %v", status, days)
+		}
+	}
+}
+
+func TestGetDaysOffError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+
+	start := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.Local)
+	if _, err := client.GetDaysOff(t.Context(), "ws1", "u1", config.Schedule{}, start, start.AddDate(0, 1, 0)); err == nil {
+		t.Error("Expected a bad API key to be reported")
+	}
+}
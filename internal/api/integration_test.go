@@ -0,0 +1,347 @@
+package api
+
+import (
+	"clockify-app/internal/api/fakeclockify"
+	"clockify-app/internal/models"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newFakeClient starts a fake Clockify server and returns a client pointed at it
+func newFakeClient(t *testing.T) (*Client, *fakeclockify.Server) {
+	t.Helper()
+
+	fake := fakeclockify.New().Start()
+	t.Cleanup(fake.Close)
+
+	return NewClient(fake.APIKey, append([]Option{WithBaseURL(fake.URL)}, testOptions...)...), fake
+}
+
+func TestFakeServerUserAndWorkspaces(t *testing.T) {
+	client, _ := newFakeClient(t)
+
+	user, err := client.GetUserInfo(t.Context())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.ID != fakeclockify.DefaultUserID {
+		t.Errorf("Expected user %q, got %q", fakeclockify.DefaultUserID, user.ID)
+	}
+
+	workspaces, err := client.GetWorkspaces(t.Context())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != fakeclockify.DefaultWorkspaceID {
+		t.Errorf("Expected the default workspace, got %+v", workspaces)
+	}
+}
+
+func TestFakeServerBadAPIKey(t *testing.T) {
+	_, fake := newFakeClient(t)
+	client := NewClient("wrong-key", append([]Option{WithBaseURL(fake.URL)}, testOptions...)...)
+
+	_, err := client.GetUserInfo(t.Context())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Expected ErrUnauthorized for a bad API key, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != 1000 {
+		t.Errorf("Expected Clockify's error code 1000, got %+v", apiErr)
+	}
+}
+
+func TestFakeServerTypedErrors(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ws := fakeclockify.DefaultWorkspaceID
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+
+	err := client.DeleteTimeEntry(t.Context(), ws, "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	// Sent raw, since the client refuses to create a backwards entry itself
+	_, err = client.Post(t.Context(), "/workspaces/"+ws+"/time-entries", models.TimeEntryRequest{
+		Start:       date.Add(11 * time.Hour).Format(time.RFC3339),
+		End:         date.Add(9 * time.Hour).Format(time.RFC3339),
+		Description: "Backwards",
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected ErrValidation, got %v", err)
+	}
+	if msg := ErrorMessage(err); msg != "End time must be after start time" {
+		t.Errorf("Expected Clockify's validation message, got %q", msg)
+	}
+
+	fake.FailNext(429, 429, "Too many requests")
+	fake.FailNext(429, 429, "Too many requests")
+	fake.FailNext(429, 429, "Too many requests")
+	if _, err := client.GetWorkspaces(t.Context()); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited once retries run out, got %v", err)
+	}
+}
+
+func TestFakeServerProjectsAndTasksAcrossPages(t *testing.T) {
+	client, fake := newFakeClient(t)
+	client.SetPageSize(3)
+
+	var first models.Project
+	for i := range 7 {
+		project := fake.AddProject(models.Project{Name: "Project"})
+		if i == 0 {
+			first = project
+		}
+	}
+	fake.AddProject(models.Project{Name: "Archived", IsArchived: true})
+	for range 4 {
+		fake.AddTask(models.Task{Name: "Task", ProjectID: first.ID})
+	}
+	fake.AddTask(models.Task{Name: "Done", ProjectID: first.ID, Status: "DONE"})
+
+	projects, err := client.GetProjects(t.Context(), fakeclockify.DefaultWorkspaceID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(projects) != 7 {
+		t.Errorf("Expected 7 active projects, got %d", len(projects))
+	}
+
+	tasks, err := client.GetTasks(t.Context(), fakeclockify.DefaultWorkspaceID, first.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(tasks) != 4 {
+		t.Errorf("Expected 4 active tasks, got %d", len(tasks))
+	}
+}
+
+func TestFakeServerEntryRoundTrip(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ws := fakeclockify.DefaultWorkspaceID
+	project := fake.AddProject(models.Project{Name: "Website"})
+	task := fake.AddTask(models.Task{Name: "Design", ProjectID: project.ID})
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+
+	created, err := client.CreateTimeEntry(t.Context(), ws, NewEntry{
+		ProjectID:   project.ID,
+		TaskID:      task.ID,
+		Description: "Mockups",
+		Billable:    true,
+		Range:       TimeRange{Start: date.Add(9 * time.Hour), End: date.Add(11 * time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error creating: %v", err)
+	}
+	if created.TaskID != task.ID || created.Duration() != 2*time.Hour || !created.Billable {
+		t.Errorf("Unexpected created entry %+v", created)
+	}
+
+	description, noTask := "Mockups v2", ""
+	end := date.Add(12 * time.Hour)
+	updated, err := client.UpdateTimeEntry(t.Context(), ws, created.ID, EntryChanges{
+		Description: &description,
+		TaskID:      &noTask,
+		End:         &end,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error updating: %v", err)
+	}
+	if updated.Description != "Mockups v2" || updated.Duration() != 3*time.Hour || !updated.Billable {
+		t.Errorf("Unexpected updated entry %+v", updated)
+	}
+
+	entries, err := client.GetEntriesInRange(t.Context(), ws, fakeclockify.DefaultUserID, date, date.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error listing: %v", err)
+	}
+	if len(entries) != 1 || entries[0].ID != created.ID {
+		t.Errorf("Expected the updated entry in range, got %+v", entries)
+	}
+
+	if err := client.DeleteTimeEntry(t.Context(), ws, created.ID); err != nil {
+		t.Fatalf("Unexpected error deleting: %v", err)
+	}
+	if got := len(fake.Entries(ws)); got != 0 {
+		t.Errorf("Expected no entries after delete, got %d", got)
+	}
+}
+
+func TestFakeServerTags(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ws := fakeclockify.DefaultWorkspaceID
+	meeting := fake.AddTag(models.Tag{Name: "Meeting"})
+	fake.AddTag(models.Tag{Name: "Old", Archived: true})
+	project := fake.AddProject(models.Project{Name: "Website"})
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+
+	tags, err := client.GetTags(t.Context(), ws)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(tags) != 1 || tags[0].ID != meeting.ID {
+		t.Errorf("Expected only the active tag, got %+v", tags)
+	}
+
+	development, err := client.CreateTag(t.Context(), ws, "Development")
+	if err != nil {
+		t.Fatalf("Unexpected error creating tag: %v", err)
+	}
+	if development.ID == "" || development.Name != "Development" {
+		t.Errorf("Unexpected created tag %+v", development)
+	}
+
+	if _, err := client.CreateTag(t.Context(), ws, "meeting"); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected a validation error for a duplicate tag, got %v", err)
+	}
+
+	tagIDs := []string{meeting.ID, development.ID}
+	created, err := client.CreateTimeEntry(t.Context(), ws, NewEntry{
+		ProjectID:   project.ID,
+		Description: "Standup",
+		TagIDs:      tagIDs,
+		Range:       TimeRange{Start: date.Add(9 * time.Hour), End: date.Add(9*time.Hour + 15*time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error creating: %v", err)
+	}
+	if len(created.TagIDs) != 2 {
+		t.Errorf("Expected both tags on the created entry, got %v", created.TagIDs)
+	}
+
+	updated, err := client.UpdateTimeEntry(t.Context(), ws, created.ID, EntryChanges{TagIDs: []string{meeting.ID}})
+	if err != nil {
+		t.Fatalf("Unexpected error updating: %v", err)
+	}
+	if len(updated.TagIDs) != 1 || updated.TagIDs[0] != meeting.ID {
+		t.Errorf("Expected only the meeting tag after editing, got %v", updated.TagIDs)
+	}
+}
+
+func TestFakeServerUpdatePreservesUntouchedFields(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ws := fakeclockify.DefaultWorkspaceID
+	project := fake.AddProject(models.Project{Name: "Website", IsBillable: true})
+	task := fake.AddTask(models.Task{Name: "Design", ProjectID: project.ID})
+	tag := fake.AddTag(models.Tag{Name: "Meeting"})
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	entry := fake.AddEntry(models.Entry{
+		Description: "Planning",
+		ProjectID:   project.ID,
+		TaskID:      task.ID,
+		TagIDs:      []string{tag.ID},
+		Billable:    true,
+		TimeInterval: models.IntervalTime{
+			Start: start,
+			End:   start.Add(time.Hour),
+		},
+	})
+	fake.SetEntryFields(ws, entry.ID, map[string]any{
+		"type": "BREAK",
+		"customFieldValues": []any{
+			map[string]any{"customFieldId": "cf1", "name": "Ticket", "value": "WEB-42"},
+		},
+	})
+
+	description := "Sprint planning"
+	if _, err := client.UpdateTimeEntry(t.Context(), ws, entry.ID, EntryChanges{Description: &description}); err != nil {
+		t.Fatalf("Unexpected error updating: %v", err)
+	}
+
+	raw, _ := fake.RawEntry(ws, entry.ID)
+	if raw["description"] != "Sprint planning" {
+		t.Errorf("Expected the description to change, got %v", raw["description"])
+	}
+	if raw["taskId"] != task.ID {
+		t.Errorf("Task should survive the edit, got %v", raw["taskId"])
+	}
+	if tags, _ := raw["tagIds"].([]any); len(tags) != 1 || tags[0] != tag.ID {
+		t.Errorf("Tags should survive the edit, got %v", raw["tagIds"])
+	}
+	if raw["billable"] != true {
+		t.Error("Billable should survive the edit")
+	}
+	if raw["type"] != "BREAK" {
+		t.Errorf("Type should survive the edit, got %v", raw["type"])
+	}
+
+	fields, _ := raw["customFieldValues"].([]any)
+	if len(fields) != 1 {
+		t.Fatalf("Custom field values should survive the edit, got %v", raw["customFieldValues"])
+	}
+	if field, _ := fields[0].(map[string]any); field["customFieldId"] != "cf1" || field["value"] != "WEB-42" {
+		t.Errorf("Unexpected custom field value %v", fields[0])
+	}
+
+	interval, _ := raw["timeInterval"].(map[string]any)
+	if interval["start"] != "2026-10-12T09:00:00Z" || interval["end"] != "2026-10-12T10:00:00Z" {
+		t.Errorf("Times should survive the edit, got %v", interval)
+	}
+}
+
+func TestFakeServerTimer(t *testing.T) {
+	client, fake := newFakeClient(t)
+	ws, user := fakeclockify.DefaultWorkspaceID, fakeclockify.DefaultUserID
+	project := fake.AddProject(models.Project{Name: "Website"})
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	if _, err := client.StartTimeEntry(t.Context(), ws, project.ID, "", "Review", nil, start); err != nil {
+		t.Fatalf("Unexpected error starting: %v", err)
+	}
+
+	running, err := client.GetRunningTimeEntry(t.Context(), ws, user)
+	if err != nil || running == nil || !running.IsRunning() {
+		t.Fatalf("Expected a running entry, got %+v (err: %v)", running, err)
+	}
+
+	stopped, err := client.StopTimeEntry(t.Context(), ws, user, start.Add(45*time.Minute))
+	if err != nil {
+		t.Fatalf("Unexpected error stopping: %v", err)
+	}
+	if stopped.Duration() != 45*time.Minute {
+		t.Errorf("Expected 45 minutes, got %v", stopped.Duration())
+	}
+
+	if running, _ := client.GetRunningTimeEntry(t.Context(), ws, user); running != nil {
+		t.Errorf("Expected no running entry after stop, got %+v", running)
+	}
+}
+
+func TestFakeServerErrors(t *testing.T) {
+	client, fake := newFakeClient(t)
+
+	// A transient server error on a GET is retried away
+	fake.FailNext(500, 500, "internal error")
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Errorf("Expected the retry to succeed, got %v", err)
+	}
+
+	// Creating an entry is not retried after a server error
+	fake.FailNext(500, 500, "internal error")
+	if _, err := client.StartTimeEntry(t.Context(), fakeclockify.DefaultWorkspaceID, "", "", "", nil, time.Now()); err == nil {
+		t.Error("Expected POST to fail without retrying")
+	}
+	if got := len(fake.Entries(fakeclockify.DefaultWorkspaceID)); got != 0 {
+		t.Errorf("Expected no entry to be created, got %d", got)
+	}
+
+	// Persistent failures give up after the last attempt
+	for range 3 {
+		fake.FailNext(503, 503, "unavailable")
+	}
+	if _, err := client.GetWorkspaces(t.Context()); err == nil {
+		t.Error("Expected an error once retries are exhausted")
+	}
+
+	// A Retry-After longer than the policy allows fails straight away
+	fake.SetRateLimit(1, time.Minute)
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("Unexpected error within the rate limit: %v", err)
+	}
+	if _, err := client.GetWorkspaces(t.Context()); err == nil {
+		t.Error("Expected an error once rate limited")
+	}
+}
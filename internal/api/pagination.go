@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Defaults for walking paginated list endpoints
+const (
+	DefaultPageSize = 200
+	DefaultMaxPages = 50
+)
+
+// ErrPageLimit is returned when a list endpoint still has data after the page cap
+// The items fetched so far are returned alongside it
+var ErrPageLimit = errors.New("page limit reached before all results were fetched")
+
+// SetPageSize sets how many items are requested per page
+func (c *Client) SetPageSize(size int) {
+	if size > 0 {
+		c.pageSize = size
+	}
+}
+
+// SetMaxPages caps how many pages a single list call will walk
+func (c *Client) SetMaxPages(pages int) {
+	if pages > 0 {
+		c.maxPages = pages
+	}
+}
+
+// getAllPages walks page/page-size on a list endpoint until a short page comes back.
+// A limit above zero stops once that many items have been collected.
+// Go doesn't allow generic methods, so the client is passed in explicitly.
+func getAllPages[T any](ctx context.Context, c *Client, endpoint string, limit int) ([]T, error) {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	var all []T
+	for page := 1; page <= c.maxPages; page++ {
+		body, err := c.Get(ctx, fmt.Sprintf("%s%spage=%d&page-size=%d", endpoint, separator, page, c.pageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		var items []T
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, fmt.Errorf("failed to parse page %d: %w", page, err)
+		}
+		all = append(all, items...)
+
+		if limit > 0 && len(all) >= limit {
+			return all[:limit], nil
+		}
+
+		// A short page means there is nothing left to fetch
+		if len(items) < c.pageSize {
+			return all, nil
+		}
+	}
+
+	return all, ErrPageLimit
+}
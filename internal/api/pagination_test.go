@@ -0,0 +1,116 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type testItem struct {
+	ID int `json:"id"`
+}
+
+func testItems(n int) []testItem {
+	items := make([]testItem, n)
+	for i := range items {
+		items[i] = testItem{ID: i + 1}
+	}
+	return items
+}
+
+func TestGetAllPages(t *testing.T) {
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, testItems(7), &requests))
+	client.SetPageSize(3)
+
+	items, err := getAllPages[testItem](t.Context(), client, "/items?archived=false", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(items) != 7 {
+		t.Errorf("Expected 7 items, got %d", len(items))
+	}
+	if items[6].ID != 7 {
+		t.Errorf("Expected last item ID 7, got %d", items[6].ID)
+	}
+
+	// 3 + 3 + 1, stopping at the short page
+	if len(requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(requests))
+	}
+
+	// Existing query params are kept
+	if requests[0].URL.Query().Get("archived") != "false" {
+		t.Errorf("Expected archived param to be kept, got %s", requests[0].URL.RawQuery)
+	}
+}
+
+func TestGetAllPages_ExactMultiple(t *testing.T) {
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, testItems(6), &requests))
+	client.SetPageSize(3)
+
+	items, err := getAllPages[testItem](t.Context(), client, "/items", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(items) != 6 {
+		t.Errorf("Expected 6 items, got %d", len(items))
+	}
+
+	// The third, empty page confirms there is nothing left
+	if len(requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(requests))
+	}
+}
+
+func TestGetAllPages_Limit(t *testing.T) {
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, testItems(20), &requests))
+	client.SetPageSize(3)
+
+	items, err := getAllPages[testItem](t.Context(), client, "/items", 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(items) != 5 {
+		t.Errorf("Expected 5 items, got %d", len(items))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestGetAllPages_MaxPages(t *testing.T) {
+	client := newTestClient(t, pagedHandler(t, testItems(20), nil))
+	client.SetPageSize(3)
+	client.SetMaxPages(2)
+
+	items, err := getAllPages[testItem](t.Context(), client, "/items", 0)
+	if !errors.Is(err, ErrPageLimit) {
+		t.Fatalf("Expected ErrPageLimit, got %v", err)
+	}
+
+	// Partial results are still returned
+	if len(items) != 6 {
+		t.Errorf("Expected 6 items, got %d", len(items))
+	}
+}
+
+func TestGetAllPages_Error(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			http.Error(w, "boom", http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":1},{"id":2}]`))
+	}))
+	client.SetPageSize(2)
+
+	if _, err := getAllPages[testItem](t.Context(), client, "/items", 0); err == nil {
+		t.Error("Expected error when a page fails")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"clockify-app/internal/cache"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -12,48 +13,59 @@ import (
 
 // GetProjects fetches all projects for a given workspace
 // Returns a slice of Project structs or an error
-func (c *Client) GetProjects(workspaceID string) ([]models.Project, error) {
+func (c *Client) GetProjects(ctx context.Context, workspaceID string) ([]models.Project, error) {
 	// Build the endpoint URL with the workspace ID
-	pageSize := "1000" // Adjust page size as needed
-	endpoint := fmt.Sprintf("/workspaces/%s/projects?page-size=%s&archived=false", workspaceID, pageSize)
+	endpoint := fmt.Sprintf("/workspaces/%s/projects?archived=false", workspaceID)
 
-	// Make the GET request
-	body, err := c.Get(endpoint)
+	// Walk every page of projects
+	return getAllPages[models.Project](ctx, c, endpoint, 0)
+}
+
+// GetProject fetches a single project by ID
+func (c *Client) GetProject(ctx context.Context, workspaceID, projectID string) (models.Project, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/projects/%s", workspaceID, projectID)
+	body, err := c.Get(ctx, endpoint)
 	if err != nil {
-		return nil, err
+		return models.Project{}, err
 	}
 
-	// Parse the JSON response into a slice of Project structs
-	var projects []models.Project
-	if err := json.Unmarshal(body, &projects); err != nil {
-		return nil, fmt.Errorf("failed to parse projects: %w", err)
+	var project models.Project
+	if err := json.Unmarshal(body, &project); err != nil {
+		return models.Project{}, fmt.Errorf("failed to parse project: %w", err)
 	}
-
-	return projects, nil
+	return project, nil
 }
 
 // FetchProjects returns a command that fetches all projects for a given workspace
+// Cached projects are shown straight away, stale or not; RevalidateProjects refreshes them.
 func FetchProjects(apiKey, workspaceId string) tea.Cmd {
-	return func() tea.Msg {
-		// Return cached projects if available
-		cache := cache.GetInstance()
-		if cachedProjects := cache.GetProjects(); cachedProjects != nil {
-			return messages.ProjectsLoadedMsg{
-				Projects: cachedProjects,
-			}
-		}
-
-		client := NewClient(apiKey)
-		projects, err := client.GetProjects(workspaceId)
+	return projectsCmd(apiKey, workspaceId, fromCache)
+}
 
-		if err != nil {
-			return messages.ErrorMsg{Err: err}
-		}
+// RevalidateProjects returns a command that refetches the cached projects when they're stale
+func RevalidateProjects(apiKey, workspaceId string) tea.Cmd {
+	return projectsCmd(apiKey, workspaceId, revalidate)
+}
 
-		cache.SetProjects(projects)
+func projectsCmd(apiKey, workspaceId string, serve serveFunc[[]models.Project]) tea.Cmd {
+	return func() tea.Msg {
+		cache := cache.GetInstance()
+		projects, fresh := cache.LookupProjects()
 
-		return messages.ProjectsLoadedMsg{
-			Projects: projects,
-		}
+		return serve(
+			cached[[]models.Project]{data: projects, found: projects != nil, fresh: fresh},
+			func() ([]models.Project, error) {
+				client := NewClient(apiKey)
+				projects, err := client.GetProjects(context.Background(), workspaceId)
+				if err != nil {
+					return nil, err
+				}
+				cache.SetProjects(projects)
+				return projects, nil
+			},
+			func(projects []models.Project) tea.Msg {
+				return messages.ProjectsLoadedMsg{Projects: projects}
+			},
+		)
 	}
 }
@@ -1,11 +1,61 @@
 package api
 
 import (
+	"clockify-app/internal/api/fakeclockify"
+	"clockify-app/internal/models"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 )
 
 func TestGetProjects(t *testing.T) {
-	// Skip this test since it requires mocking the HTTP client
-	// This would require modifying the Client struct to accept a custom base URL
-	t.Skip("Skipping integration test - would require API client refactoring for proper mocking")
+	projects := make([]models.Project, 5)
+	for i := range projects {
+		projects[i] = models.Project{ID: fmt.Sprintf("p%d", i+1), Name: fmt.Sprintf("Project %d", i+1)}
+	}
+
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, projects, &requests))
+	client.SetPageSize(2)
+
+	result, err := client.GetProjects(t.Context(), "ws1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result) != 5 {
+		t.Errorf("Expected 5 projects across pages, got %d", len(result))
+	}
+	if result[4].ID != "p5" {
+		t.Errorf("Expected last project p5, got %q", result[4].ID)
+	}
+
+	if !strings.HasSuffix(requests[0].URL.Path, "/workspaces/ws1/projects") {
+		t.Errorf("Unexpected path %q", requests[0].URL.Path)
+	}
+	if requests[0].URL.Query().Get("archived") != "false" {
+		t.Error("Archived projects should be excluded")
+	}
+	if requests[0].Header.Get("X-Api-Key") != "test-api-key" {
+		t.Error("API key header should be set")
+	}
+}
+
+func TestGetProject(t *testing.T) {
+	client, fake := newFakeClient(t)
+	fake.AddProject(models.Project{Name: "Other"})
+	project := fake.AddProject(models.Project{Name: "Website"})
+
+	result, err := client.GetProject(t.Context(), fakeclockify.DefaultWorkspaceID, project.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Name != "Website" {
+		t.Errorf("Expected the Website project, got %+v", result)
+	}
+
+	if _, err := client.GetProject(t.Context(), fakeclockify.DefaultWorkspaceID, "missing"); err == nil {
+		t.Error("Expected an error for an unknown project")
+	}
 }
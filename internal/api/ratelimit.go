@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clockify allows 50 requests per second per API key, stay well under it
+const (
+	DefaultRateLimit = 25 // requests per second
+	DefaultRateBurst = 25
+)
+
+// RateLimiter is a token bucket shared by every client that uses it
+// Each request takes one token; tokens refill continuously at the configured rate
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a full bucket allowing perSecond requests with bursts of up to burst
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// sharedLimiter throttles every client by default, so concurrent commands
+// like the per-project task fetches draw from the same budget
+var sharedLimiter = NewRateLimiter(DefaultRateLimit, DefaultRateBurst)
+
+// Wait blocks until a token is available or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available, otherwise returns how long until one is
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	missing := 1 - l.tokens
+	return time.Duration(missing / l.rate * float64(time.Second))
+}
+
+// sleep waits for d, returning early with ctx's error if it is cancelled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurstAndRefill(t *testing.T) {
+	now := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(10, 2)
+	limiter.now = func() time.Time { return now }
+
+	// The bucket starts full
+	for i := range 2 {
+		if d := limiter.reserve(); d != 0 {
+			t.Fatalf("Request %d should pass immediately, got wait %v", i+1, d)
+		}
+	}
+
+	// Empty bucket: one token takes 100ms at 10/s
+	if d := limiter.reserve(); d != 100*time.Millisecond {
+		t.Errorf("Expected a 100ms wait, got %v", d)
+	}
+
+	now = now.Add(100 * time.Millisecond)
+	if d := limiter.reserve(); d != 0 {
+		t.Errorf("Expected a token after refilling, got wait %v", d)
+	}
+
+	// Refills never exceed the burst
+	now = now.Add(time.Hour)
+	for range 2 {
+		limiter.reserve()
+	}
+	if d := limiter.reserve(); d == 0 {
+		t.Error("Bucket should hold at most burst tokens")
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(100, 1)
+
+	start := time.Now()
+	for range 3 {
+		if err := limiter.Wait(t.Context()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// Two refills at 100/s
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("Expected the limiter to pace requests, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	limiter := NewRateLimiter(0.01, 1)
+	limiter.reserve()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context canceled, got %v", err)
+	}
+}
+
+func TestClientsShareLimiter(t *testing.T) {
+	a, b := NewClient("a"), NewClient("b")
+	if a.limiter == nil || a.limiter != b.limiter {
+		t.Error("Clients should share the default rate limiter")
+	}
+
+	if c := NewClient("c", WithRateLimiter(nil)); c.limiter != nil {
+		t.Error("WithRateLimiter(nil) should disable limiting")
+	}
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyHandler answers with the given statuses in order, then 200
+func flakyHandler(retryAfter string, statuses ...int) (http.Handler, *atomic.Int32) {
+	var calls atomic.Int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}), &calls
+}
+
+func TestRetryIdempotentServerError(t *testing.T) {
+	handler, calls := flakyHandler("", http.StatusServiceUnavailable, http.StatusBadGateway)
+	client := newTestClient(t, handler)
+
+	if _, err := client.Get(t.Context(), "/workspaces"); err != nil {
+		t.Fatalf("Expected the retries to succeed, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	handler, calls := flakyHandler("", 500, 500, 500, 500)
+	client := newTestClient(t, handler)
+
+	if _, err := client.Get(t.Context(), "/workspaces"); err == nil {
+		t.Error("Expected an error once attempts are exhausted")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected MaxAttempts (3) attempts, got %d", calls.Load())
+	}
+}
+
+func TestNoRetryForPostServerError(t *testing.T) {
+	handler, calls := flakyHandler("", http.StatusInternalServerError)
+	client := newTestClient(t, handler)
+
+	if _, err := client.Post(t.Context(), "/time-entries", map[string]string{}); err == nil {
+		t.Error("Expected POST to fail")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("POST must not be retried after a server error, got %d attempts", calls.Load())
+	}
+}
+
+func TestNoRetryForClientError(t *testing.T) {
+	handler, calls := flakyHandler("", http.StatusBadRequest)
+	client := newTestClient(t, handler)
+
+	if _, err := client.Get(t.Context(), "/workspaces"); err == nil {
+		t.Error("Expected a 400 to fail")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Client errors must not be retried, got %d attempts", calls.Load())
+	}
+}
+
+func TestRetryRateLimitedPost(t *testing.T) {
+	handler, calls := flakyHandler("0", http.StatusTooManyRequests)
+	client := newTestClient(t, handler)
+
+	if _, err := client.Post(t.Context(), "/time-entries", map[string]string{}); err != nil {
+		t.Fatalf("Expected a rate-limited POST to be retried, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	handler, calls := flakyHandler("120", http.StatusTooManyRequests)
+	client := newTestClient(t, handler)
+
+	start := time.Now()
+	if _, err := client.Get(t.Context(), "/workspaces"); err == nil {
+		t.Error("Expected an error when Retry-After exceeds the max delay")
+	}
+	if calls.Load() != 1 || time.Since(start) > time.Second {
+		t.Errorf("Expected an immediate failure, got %d attempts in %v", calls.Load(), time.Since(start))
+	}
+}
+
+func TestRetryAfterHeader(t *testing.T) {
+	now := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"0", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{now.Add(10 * time.Second).Format(http.TimeFormat), 10 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.value != "" {
+			header.Set("Retry-After", tt.value)
+		}
+
+		got, ok := retryAfter(header, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	for attempt, ceiling := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 300 * time.Millisecond, // capped
+		9: 300 * time.Millisecond,
+	} {
+		for range 50 {
+			if d := policy.backoff(attempt); d <= 0 || d > ceiling {
+				t.Fatalf("backoff(%d) = %v, want within (0, %v]", attempt, d, ceiling)
+			}
+		}
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{http.MethodGet, http.StatusServiceUnavailable, true},
+		{http.MethodPut, http.StatusInternalServerError, true},
+		{http.MethodDelete, http.StatusGatewayTimeout, true},
+		{http.MethodPost, http.StatusInternalServerError, false},
+		{http.MethodPatch, http.StatusBadGateway, false},
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodGet, http.StatusNotFound, false},
+		{http.MethodGet, http.StatusUnauthorized, false},
+	}
+
+	for _, tt := range tests {
+		if got := shouldRetry(tt.method, tt.status, nil); got != tt.want {
+			t.Errorf("shouldRetry(%s, %d) = %v, want %v", tt.method, tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"bytes"
+	"clockify-app/internal/messages"
+	"encoding/json"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// cached is what the cache holds for a fetch: whether it had anything, and whether it's fresh
+type cached[T any] struct {
+	data  T
+	found bool
+	fresh bool
+}
+
+// serveFunc decides what a command built on the cache reports: fromCache or revalidate
+type serveFunc[T any] func(c cached[T], fetch func() (T, error), loaded func(T) tea.Msg) tea.Msg
+
+// fromCache serves cached data straight away, however old it is, and only
+// fetches when nothing is cached. Stale data is refreshed by revalidate, which
+// runs alongside so that commands waiting on this one aren't held up by it.
+func fromCache[T any](c cached[T], fetch func() (T, error), loaded func(T) tea.Msg) tea.Msg {
+	if c.found {
+		return loaded(c.data)
+	}
+
+	data, err := fetch()
+	if err != nil {
+		return messages.ErrorMsg{Err: err}
+	}
+	return loaded(data)
+}
+
+// revalidate fetches stale cached data again in the background. It only reports
+// back when the data changed, so views aren't disturbed for nothing. Fresh data
+// and an empty cache, which fromCache fetches itself, are left alone.
+func revalidate[T any](c cached[T], fetch func() (T, error), loaded func(T) tea.Msg) tea.Msg {
+	if !c.found || c.fresh {
+		return nil
+	}
+
+	data, err := fetch()
+	if err != nil {
+		return messages.ErrorMsg{Err: err}
+	}
+	if sameJSON(data, c.data) {
+		return nil
+	}
+	return loaded(data)
+}
+
+// sameJSON compares values the way they're stored, ignoring details like time zone pointers
+func sameJSON(a, b any) bool {
+	x, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	y, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
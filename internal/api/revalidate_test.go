@@ -0,0 +1,92 @@
+package api
+
+import (
+	"clockify-app/internal/cache"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"clockify-app/internal/storage"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchProjectsRevalidates(t *testing.T) {
+	remote := []models.Project{{ID: "p1", Name: "Website"}}
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pagedHandler(t, remote, &requests)(w, r)
+	}))
+	defer server.Close()
+
+	Configure(WithBaseURL(server.URL))
+	defer Configure()
+
+	// Start from projects stored by an earlier launch
+	store := storage.New(t.TempDir())
+	if err := store.Save("ws1", "projects", remote); err != nil {
+		t.Fatal(err)
+	}
+	c := cache.GetInstance()
+	if err := c.Attach(store, "ws1"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Attach(nil, "") })
+
+	// Stale projects are shown at once, and an unchanged refetch stays quiet
+	if loaded, ok := FetchProjects("key", "ws1")().(messages.ProjectsLoadedMsg); !ok || len(loaded.Projects) != 1 || len(requests) != 0 {
+		t.Errorf("Expected the stored projects without a request, got %+v after %d requests", loaded, len(requests))
+	}
+	if msg := RevalidateProjects("key", "ws1")(); msg != nil || len(requests) != 1 {
+		t.Errorf("Expected one quiet refetch, got %+v after %d requests", msg, len(requests))
+	}
+
+	// Fresh projects skip the network
+	if _, ok := FetchProjects("key", "ws1")().(messages.ProjectsLoadedMsg); !ok || len(requests) != 1 {
+		t.Errorf("Expected fresh projects without a request, got %d requests", len(requests))
+	}
+	if msg := RevalidateProjects("key", "ws1")(); msg != nil || len(requests) != 1 {
+		t.Errorf("Expected fresh projects not to be refetched, got %+v after %d requests", msg, len(requests))
+	}
+
+	// A change on the server is reported once the stale copy has been shown
+	if err := c.Attach(store, "ws1"); err != nil {
+		t.Fatal(err)
+	}
+	remote = append(remote, models.Project{ID: "p2", Name: "API"})
+	if loaded, ok := FetchProjects("key", "ws1")().(messages.ProjectsLoadedMsg); !ok || len(loaded.Projects) != 1 {
+		t.Errorf("Expected the stored projects first, got %+v", loaded)
+	}
+	if loaded, ok := RevalidateProjects("key", "ws1")().(messages.ProjectsLoadedMsg); !ok || len(loaded.Projects) != 2 {
+		t.Errorf("Expected the new project after revalidating, got %+v", loaded)
+	}
+	if projects, fresh := c.LookupProjects(); len(projects) != 2 || !fresh {
+		t.Errorf("Expected the cache to hold the revalidated projects, got %+v", projects)
+	}
+
+	// With nothing cached the fetch goes to the network itself, and revalidating has nothing to do
+	c.InvalidateProjects()
+	if loaded, ok := FetchProjects("key", "ws1")().(messages.ProjectsLoadedMsg); !ok || len(loaded.Projects) != 2 || len(requests) != 3 {
+		t.Errorf("Expected the projects from a request, got %+v after %d requests", loaded, len(requests))
+	}
+	c.InvalidateProjects()
+	if msg := RevalidateProjects("key", "ws1")(); msg != nil || len(requests) != 3 {
+		t.Errorf("Expected nothing to revalidate, got %+v after %d requests", msg, len(requests))
+	}
+}
+
+func TestFetchTasksTagsProject(t *testing.T) {
+	server := httptest.NewServer(pagedHandler(t, []models.Task{{ID: "k1", Name: "Review"}}, nil))
+	defer server.Close()
+
+	Configure(WithBaseURL(server.URL))
+	defer Configure()
+
+	c := cache.GetInstance()
+	c.InvalidateProjectTasks("p1")
+	defer c.InvalidateProjectTasks("p1")
+
+	loaded, ok := FetchTasks("key", "ws1", "p1")().(messages.TasksLoadedMsg)
+	if !ok || loaded.ProjectID != "p1" || len(loaded.Tasks) != 1 {
+		t.Errorf("Expected p1's tasks, got %+v", loaded)
+	}
+}
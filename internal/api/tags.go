@@ -0,0 +1,88 @@
+package api
+
+import (
+	"clockify-app/internal/cache"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// GetTags fetches every active tag in a workspace
+func (c *Client) GetTags(ctx context.Context, workspaceID string) ([]models.Tag, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/tags?archived=false", workspaceID)
+
+	// Walk every page of tags
+	return getAllPages[models.Tag](ctx, c, endpoint, 0)
+}
+
+// CreateTag creates a tag in a workspace
+// Clockify rejects names that are already taken with a validation error
+func (c *Client) CreateTag(ctx context.Context, workspaceID, name string) (models.Tag, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/tags", workspaceID)
+	bytes, err := c.Post(ctx, endpoint, models.TagRequest{Name: name})
+	if err != nil {
+		return models.Tag{}, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	var tag models.Tag
+	if err := json.Unmarshal(bytes, &tag); err != nil {
+		return models.Tag{}, fmt.Errorf("failed to parse created tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// FetchTags returns a command that fetches all tags for a given workspace
+// Cached tags are shown straight away, stale or not; RevalidateTags refreshes them.
+func FetchTags(apiKey, workspaceId string) tea.Cmd {
+	return tagsCmd(apiKey, workspaceId, fromCache)
+}
+
+// RevalidateTags returns a command that refetches the cached tags when they're stale
+func RevalidateTags(apiKey, workspaceId string) tea.Cmd {
+	return tagsCmd(apiKey, workspaceId, revalidate)
+}
+
+func tagsCmd(apiKey, workspaceId string, serve serveFunc[[]models.Tag]) tea.Cmd {
+	return func() tea.Msg {
+		cache := cache.GetInstance()
+		tags, fresh := cache.LookupTags()
+
+		return serve(
+			cached[[]models.Tag]{data: tags, found: tags != nil, fresh: fresh},
+			func() ([]models.Tag, error) {
+				client := NewClient(apiKey)
+				tags, err := client.GetTags(context.Background(), workspaceId)
+				if err != nil {
+					return nil, err
+				}
+				cache.SetTags(tags)
+				return tags, nil
+			},
+			func(tags []models.Tag) tea.Msg {
+				return messages.TagsLoadedMsg{Tags: tags}
+			},
+		)
+	}
+}
+
+// AddTag returns a command that creates a tag and adds it to the cache
+func AddTag(apiKey, workspaceId, name string) tea.Cmd {
+	return func() tea.Msg {
+		client := NewClient(apiKey)
+		tag, err := client.CreateTag(context.Background(), workspaceId, name)
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		cache.GetInstance().AddTag(tag)
+
+		return messages.TagCreatedMsg{
+			Tag: tag,
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGetTags(t *testing.T) {
+	tags := make([]models.Tag, 3)
+	for i := range tags {
+		tags[i] = models.Tag{ID: fmt.Sprintf("t%d", i+1), Name: fmt.Sprintf("Tag %d", i+1)}
+	}
+
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, tags, &requests))
+	client.SetPageSize(2)
+
+	result, err := client.GetTags(t.Context(), "ws1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result) != 3 {
+		t.Errorf("Expected 3 tags across pages, got %d", len(result))
+	}
+	if !strings.HasSuffix(requests[0].URL.Path, "/workspaces/ws1/tags") {
+		t.Errorf("Unexpected path %q", requests[0].URL.Path)
+	}
+	if requests[0].URL.Query().Get("archived") != "false" {
+		t.Error("Archived tags should be excluded")
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	var sent models.TagRequest
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/workspaces/ws1/tags" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"t1","name":"Meeting","workspaceId":"ws1"}`))
+	}))
+
+	tag, err := client.CreateTag(t.Context(), "ws1", "Meeting")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if sent.Name != "Meeting" {
+		t.Errorf("Unexpected payload %+v", sent)
+	}
+	if tag.ID != "t1" {
+		t.Errorf("Expected tag ID 't1', got %q", tag.ID)
+	}
+}
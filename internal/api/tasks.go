@@ -4,69 +4,127 @@ import (
 	"clockify-app/internal/cache"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	tea "charm.land/bubbletea/v2"
 )
 
-func (c *Client) GetTasks(workspaceID, projectID string) ([]models.Task, error) {
+// GetTasks fetches every active task for a project
+func (c *Client) GetTasks(ctx context.Context, workspaceID, projectID string) ([]models.Task, error) {
 	// Build the endpoint URL with the workspace ID and project ID
-	pageSize := "100" // Adjust page size as needed
-	endpoint := fmt.Sprintf("/workspaces/%s/projects/%s/tasks?page-size=%s&is-active=true", workspaceID, projectID, pageSize)
+	endpoint := fmt.Sprintf("/workspaces/%s/projects/%s/tasks?is-active=true", workspaceID, projectID)
 
-	// Make the GET request
-	body, err := c.Get(endpoint)
+	// Walk every page of tasks
+	return getAllPages[models.Task](ctx, c, endpoint, 0)
+}
+
+// GetTask fetches a single task of a project by ID
+func (c *Client) GetTask(ctx context.Context, workspaceID, projectID, taskID string) (models.Task, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/projects/%s/tasks/%s", workspaceID, projectID, taskID)
+	body, err := c.Get(ctx, endpoint)
 	if err != nil {
-		return nil, err
+		return models.Task{}, err
 	}
 
-	// Parse the JSON response into a slice of Task structs
-	var tasks []models.Task
-	if err := json.Unmarshal(body, &tasks); err != nil {
-		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	var task models.Task
+	if err := json.Unmarshal(body, &task); err != nil {
+		return models.Task{}, fmt.Errorf("failed to parse task: %w", err)
 	}
-
-	return tasks, nil
+	return task, nil
 }
 
 // FetchTasks returns a command that fetches all tasks for a given project in a workspace
+// Cached tasks are shown straight away, stale or not; RevalidateTasks refreshes them.
 func FetchTasks(apiKey, workspaceId, projectId string) tea.Cmd {
-	return func() tea.Msg {
+	return tasksCmd(apiKey, workspaceId, projectId, fromCache)
+}
+
+// RevalidateTasks returns a command that refetches a project's cached tasks when they're stale
+func RevalidateTasks(apiKey, workspaceId, projectId string) tea.Cmd {
+	return tasksCmd(apiKey, workspaceId, projectId, revalidate)
+}
 
+func tasksCmd(apiKey, workspaceId, projectId string, serve serveFunc[[]models.Task]) tea.Cmd {
+	return func() tea.Msg {
 		cache := cache.GetInstance()
-		if cachedTasks := cache.GetProjectTasks(projectId); cachedTasks != nil {
-			return messages.TasksLoadedMsg{
-				Tasks: cachedTasks,
-			}
-		}
+		tasks, found, fresh := cache.LookupProjectTasks(projectId)
 
-		client := NewClient(apiKey)
-		tasks, err := client.GetTasks(workspaceId, projectId)
+		return serve(
+			cached[[]models.Task]{data: tasks, found: found, fresh: fresh},
+			func() ([]models.Task, error) {
+				client := NewClient(apiKey)
+				tasks, err := client.GetTasks(context.Background(), workspaceId, projectId)
+				if err != nil {
+					return nil, err
+				}
+				cache.SetProjectTasks(projectId, tasks)
+				return tasks, nil
+			},
+			func(tasks []models.Task) tea.Msg {
+				return messages.TasksLoadedMsg{ProjectID: projectId, Tasks: tasks}
+			},
+		)
+	}
+}
 
-		if err != nil {
-			return messages.ErrorMsg{Err: err}
-		}
+// taskFetchWorkers caps concurrent requests in FetchTasksForAllProjects
+// The shared rate limiter paces them, this only bounds how many are open at once
+const taskFetchWorkers = 4
+
+// GetTasksForProjects fetches the active tasks of every project, a few at a time
+func (c *Client) GetTasksForProjects(ctx context.Context, workspaceID string, projects []models.Project) (map[string][]models.Task, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		allTasks = make(map[string][]models.Task, len(projects))
+		jobs     = make(chan string)
+	)
 
-		cache.SetProjectTasks(projectId, tasks)
+	for range min(taskFetchWorkers, len(projects)) {
+		wg.Go(func() {
+			for projectID := range jobs {
+				tasks, err := c.GetTasks(ctx, workspaceID, projectID)
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel() // Stop the remaining fetches
+				}
+				allTasks[projectID] = tasks
+				mu.Unlock()
+			}
+		})
+	}
 
-		return messages.TasksLoadedMsg{
-			Tasks: tasks,
+	for _, project := range projects {
+		if ctx.Err() != nil {
+			break
 		}
+		jobs <- project.ID
 	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return allTasks, nil
 }
 
+// FetchTasksForAllProjects returns a command that fetches the tasks of every given project
 func FetchTasksForAllProjects(apiKey, workspaceId string, projects []models.Project) tea.Cmd {
 	return func() tea.Msg {
 		client := NewClient(apiKey)
-		allTasks := make(map[string][]models.Task)
-
-		for _, project := range projects {
-			tasks, err := client.GetTasks(workspaceId, project.ID)
-			if err != nil {
-				return messages.ErrorMsg{Err: err}
-			}
-			allTasks[project.ID] = tasks
+		allTasks, err := client.GetTasksForProjects(context.Background(), workspaceId, projects)
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
 		}
 
 		return messages.AllTasksLoadedMsg{
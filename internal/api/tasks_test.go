@@ -1,10 +1,92 @@
 package api
 
 import (
+	"clockify-app/internal/api/fakeclockify"
+	"clockify-app/internal/models"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 )
 
 func TestGetTasks(t *testing.T) {
-	// Skip this test since it requires mocking the HTTP client
-	t.Skip("Skipping integration test - would require API client refactoring for proper mocking")
+	tasks := make([]models.Task, 4)
+	for i := range tasks {
+		tasks[i] = models.Task{ID: fmt.Sprintf("t%d", i+1), Name: fmt.Sprintf("Task %d", i+1), ProjectID: "p1"}
+	}
+
+	var requests []*http.Request
+	client := newTestClient(t, pagedHandler(t, tasks, &requests))
+	client.SetPageSize(3)
+
+	result, err := client.GetTasks(t.Context(), "ws1", "p1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result) != 4 {
+		t.Errorf("Expected 4 tasks across pages, got %d", len(result))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(requests))
+	}
+
+	if !strings.HasSuffix(requests[0].URL.Path, "/workspaces/ws1/projects/p1/tasks") {
+		t.Errorf("Unexpected path %q", requests[0].URL.Path)
+	}
+	if requests[0].URL.Query().Get("is-active") != "true" {
+		t.Error("Only active tasks should be requested")
+	}
+}
+
+func TestGetTasksForProjects(t *testing.T) {
+	client, fake := newFakeClient(t)
+
+	var projects []models.Project
+	for i := range 10 {
+		project := fake.AddProject(models.Project{Name: fmt.Sprintf("Project %d", i)})
+		fake.AddTask(models.Task{Name: "Task", ProjectID: project.ID})
+		projects = append(projects, project)
+	}
+
+	tasks, err := client.GetTasksForProjects(t.Context(), fakeclockify.DefaultWorkspaceID, projects)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(tasks) != 10 {
+		t.Fatalf("Expected tasks for 10 projects, got %d", len(tasks))
+	}
+	for _, project := range projects {
+		if len(tasks[project.ID]) != 1 {
+			t.Errorf("Expected 1 task for %s, got %d", project.Name, len(tasks[project.ID]))
+		}
+	}
+
+	// Any failed project fails the whole fetch
+	missing := append(projects, models.Project{ID: "missing"})
+	if _, err := client.GetTasksForProjects(t.Context(), fakeclockify.DefaultWorkspaceID, missing); err == nil {
+		t.Error("Expected an error for an unknown project")
+	}
+}
+
+func TestGetTask(t *testing.T) {
+	client, fake := newFakeClient(t)
+	project := fake.AddProject(models.Project{Name: "Website"})
+	fake.AddTask(models.Task{Name: "Design", ProjectID: project.ID})
+	task := fake.AddTask(models.Task{Name: "Build", ProjectID: project.ID})
+
+	result, err := client.GetTask(t.Context(), fakeclockify.DefaultWorkspaceID, project.ID, task.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Name != "Build" {
+		t.Errorf("Expected the Build task, got %+v", result)
+	}
+
+	if _, err := client.GetTask(t.Context(), fakeclockify.DefaultWorkspaceID, "missing", task.ID); err == nil {
+		t.Error("Expected an error for an unknown project")
+	}
+	if _, err := client.GetTask(t.Context(), fakeclockify.DefaultWorkspaceID, project.ID, "missing"); err == nil {
+		t.Error("Expected an error for an unknown task")
+	}
 }
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// StartTimeEntry starts a running timer in Clockify
+// The entry is created without an end time, which Clockify treats as in progress
+func (c *Client) StartTimeEntry(ctx context.Context, workspaceID, projectID, taskID, description string, tagIDs []string, start time.Time) (models.Entry, error) {
+	entry := models.TimeEntryRequest{
+		Start:       start.Format(time.RFC3339),
+		ProjectID:   projectID,
+		TaskID:      taskID,
+		Description: description,
+		TagIDs:      tagIDs,
+	}
+
+	endpoint := fmt.Sprintf("/workspaces/%s/time-entries", workspaceID)
+	bytes, err := c.Post(ctx, endpoint, entry)
+	if err != nil {
+		return models.Entry{}, fmt.Errorf("failed to start timer: %w", err)
+	}
+
+	var started models.Entry
+	if err := json.Unmarshal(bytes, &started); err != nil {
+		return models.Entry{}, fmt.Errorf("failed to parse started timer: %w", err)
+	}
+
+	return started, nil
+}
+
+// StopTimeEntry stops the user's running timer at the given end time
+// Clockify returns the now-closed entry
+func (c *Client) StopTimeEntry(ctx context.Context, workspaceID, userID string, end time.Time) (models.Entry, error) {
+	body := models.StopTimerRequest{
+		End: end.Format(time.RFC3339),
+	}
+
+	endpoint := fmt.Sprintf("/workspaces/%s/user/%s/time-entries", workspaceID, userID)
+	bytes, err := c.Patch(ctx, endpoint, body)
+	if err != nil {
+		return models.Entry{}, fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	var stopped models.Entry
+	if err := json.Unmarshal(bytes, &stopped); err != nil {
+		return models.Entry{}, fmt.Errorf("failed to parse stopped timer: %w", err)
+	}
+
+	return stopped, nil
+}
+
+// GetRunningTimeEntry fetches the user's in-progress entry
+// Returns nil when no timer is running
+func (c *Client) GetRunningTimeEntry(ctx context.Context, workspaceID, userID string) (*models.Entry, error) {
+	endpoint := fmt.Sprintf("/workspaces/%s/user/%s/time-entries?in-progress=true", workspaceID, userID)
+	body, err := c.Get(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []models.Entry
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse running entry: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	return &entries[0], nil
+}
+
+// FetchRunningTimer returns a command that loads the user's running timer, if any
+func FetchRunningTimer(apiKey, workspaceId, userId string) tea.Cmd {
+	return func() tea.Msg {
+		client := NewClient(apiKey)
+		entry, err := client.GetRunningTimeEntry(context.Background(), workspaceId, userId)
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.RunningTimerLoadedMsg{
+			Entry: entry,
+		}
+	}
+}
+
+// StartTimer returns a command that starts a new running timer now
+func StartTimer(apiKey, workspaceId, projectId, taskId, description string, tagIds []string) tea.Cmd {
+	return func() tea.Msg {
+		client := NewClient(apiKey)
+		entry, err := client.StartTimeEntry(context.Background(), workspaceId, projectId, taskId, description, tagIds, time.Now())
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.TimerStartedMsg{
+			Entry: entry,
+		}
+	}
+}
+
+// StopTimer returns a command that stops the user's running timer now
+func StopTimer(apiKey, workspaceId, userId string) tea.Cmd {
+	return func() tea.Msg {
+		client := NewClient(apiKey)
+		entry, err := client.StopTimeEntry(context.Background(), workspaceId, userId, time.Now())
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.TimerStoppedMsg{
+			Entry: entry,
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"clockify-app/internal/models"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStartTimeEntry(t *testing.T) {
+	var sent map[string]any
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/workspaces/ws1/time-entries" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"e1","description":"Review","timeInterval":{"start":"2026-10-12T09:00:00Z","end":null}}`))
+	}))
+
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	entry, err := client.StartTimeEntry(t.Context(), "ws1", "p1", "", "Review", nil, start)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok := sent["end"]; ok {
+		t.Error("A running timer must be created without an end")
+	}
+	if sent["start"] != "2026-10-12T09:00:00Z" {
+		t.Errorf("Unexpected start %v", sent["start"])
+	}
+	if !entry.IsRunning() {
+		t.Error("Started entry should be running")
+	}
+}
+
+func TestStopTimeEntry(t *testing.T) {
+	var sent models.StopTimerRequest
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/workspaces/ws1/user/u1/time-entries" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"id":"e1","timeInterval":{"start":"2026-10-12T09:00:00Z","end":"2026-10-12T10:00:00Z"}}`))
+	}))
+
+	end := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	entry, err := client.StopTimeEntry(t.Context(), "ws1", "u1", end)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if sent.End != "2026-10-12T10:00:00Z" {
+		t.Errorf("Unexpected end %q", sent.End)
+	}
+	if entry.IsRunning() || entry.Duration() != time.Hour {
+		t.Errorf("Stopped entry should last an hour, got %v", entry.Duration())
+	}
+}
+
+func TestGetRunningTimeEntry(t *testing.T) {
+	body := `[{"id":"e1","timeInterval":{"start":"2026-10-12T09:00:00Z","end":null}}]`
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("in-progress") != "true" {
+			t.Error("Expected in-progress filter")
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+
+	entry, err := client.GetRunningTimeEntry(t.Context(), "ws1", "u1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if entry == nil || entry.ID != "e1" {
+		t.Fatalf("Expected running entry e1, got %+v", entry)
+	}
+
+	// No running timer
+	body = `[]`
+	entry, err = client.GetRunningTimeEntry(t.Context(), "ws1", "u1")
+	if err != nil || entry != nil {
+		t.Errorf("Expected no running entry, got %+v (err: %v)", entry, err)
+	}
+}
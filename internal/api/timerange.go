@@ -0,0 +1,57 @@
+package api
+
+import (
+	"time"
+)
+
+// MaxEntryDuration is the longest single entry the app will send
+const MaxEntryDuration = 24 * time.Hour
+
+// Validation errors for a TimeRange, all of them match ErrValidation
+var (
+	ErrEndBeforeStart = rangeError("end time must be after start time")
+	ErrZeroLength     = rangeError("entry must be longer than zero minutes")
+	ErrTooLong        = rangeError("entry cannot be longer than 24 hours")
+)
+
+// rangeError is a TimeRange that Clockify would reject or that is almost certainly a typo
+type rangeError string
+
+func (e rangeError) Error() string {
+	return string(e)
+}
+
+// Is lets callers treat local validation failures like the ones Clockify returns
+func (e rangeError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// TimeRange is the start and end of a finished entry
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewTimeRange returns the range between start and end, or the reason it is invalid
+func NewTimeRange(start, end time.Time) (TimeRange, error) {
+	r := TimeRange{Start: start, End: end}
+	return r, r.Validate()
+}
+
+// Duration returns the length of the range
+func (r TimeRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Validate rejects ranges that end before they start, are empty, or are longer than MaxEntryDuration
+func (r TimeRange) Validate() error {
+	switch d := r.Duration(); {
+	case d < 0:
+		return ErrEndBeforeStart
+	case d == 0:
+		return ErrZeroLength
+	case d > MaxEntryDuration:
+		return ErrTooLong
+	}
+	return nil
+}
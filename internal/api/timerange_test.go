@@ -0,0 +1,38 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeRangeValidate(t *testing.T) {
+	start := time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name    string
+		end     time.Time
+		wantErr error
+	}{
+		{"valid", start.Add(90 * time.Minute), nil},
+		{"full day", start.Add(24 * time.Hour), nil},
+		{"end before start", start.Add(-time.Hour), ErrEndBeforeStart},
+		{"zero length", start, ErrZeroLength},
+		{"longer than a day", start.Add(24*time.Hour + time.Minute), ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewTimeRange(start, tt.end)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("Expected %v to match ErrValidation", err)
+			}
+			if r.Duration() != tt.end.Sub(start) {
+				t.Errorf("Unexpected duration %v", r.Duration())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -12,9 +13,9 @@ import (
 // GetUserInfo fetches the current user's information from Clockify
 // This includes their user ID and default workspace ID
 // Returns UserInfo or an error if the request fails
-func (c *Client) GetUserInfo() (*models.User, error) {
+func (c *Client) GetUserInfo(ctx context.Context) (*models.User, error) {
 	// Make a GET request to /user endpoint
-	body, err := c.Get("/user")
+	body, err := c.Get(ctx, "/user")
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func FetchUserInfo(apiKey string) tea.Cmd {
 	return func() tea.Msg {
 		// Create API client and fetch user info
 		client := NewClient(apiKey)
-		userInfo, err := client.GetUserInfo()
+		userInfo, err := client.GetUserInfo(context.Background())
 
 		// If error, return error message
 		if err != nil {
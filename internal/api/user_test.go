@@ -1,10 +1,27 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 )
 
 func TestGetUserInfo(t *testing.T) {
-	// Skip this test since it requires mocking the HTTP client
-	t.Skip("Skipping integration test - would require API client refactoring for proper mocking")
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user" {
+			t.Errorf("Unexpected path %q", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id":"u1","email":"jane@example.com","defaultWorkspace":"ws1"}`))
+	}))
+
+	user, err := client.GetUserInfo(t.Context())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if user.ID != "u1" {
+		t.Errorf("Expected user ID 'u1', got %q", user.ID)
+	}
+	if user.Email != "jane@example.com" {
+		t.Errorf("Expected email 'jane@example.com', got %q", user.Email)
+	}
 }
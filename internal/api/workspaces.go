@@ -2,12 +2,13 @@ package api
 
 import (
 	"clockify-app/internal/models"
+	"context"
 	"encoding/json"
 )
 
-func (c *Client) GetWorkspaces() ([]models.Workspace, error) {
+func (c *Client) GetWorkspaces(ctx context.Context) ([]models.Workspace, error) {
 	var workspaces []models.Workspace
-	data, err := c.Get("/workspaces")
+	data, err := c.Get(ctx, "/workspaces")
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,27 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 )
 
 func TestGetWorkspaces(t *testing.T) {
-	// Skip this test since it requires mocking the HTTP client
-	t.Skip("Skipping integration test - would require API client refactoring for proper mocking")
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/workspaces" {
+			t.Errorf("Unexpected path %q", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`[{"id":"ws1","name":"Acme"},{"id":"ws2","name":"Side Project"}]`))
+	}))
+
+	workspaces, err := client.GetWorkspaces(t.Context())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(workspaces) != 2 {
+		t.Fatalf("Expected 2 workspaces, got %d", len(workspaces))
+	}
+	if workspaces[1].Name != "Side Project" {
+		t.Errorf("Expected 'Side Project', got %q", workspaces[1].Name)
+	}
 }
@@ -0,0 +1,100 @@
+// Package balance keeps the overtime ledger: the time logged against the
+// work schedule's targets, week by week, over a period.
+package balance
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
+	"clockify-app/internal/models"
+	"fmt"
+	"time"
+)
+
+// Week is one line of the ledger
+// The first and last weeks are cut to the ledger's period.
+type Week struct {
+	Start   time.Time     // Midnight of the week's first day in the period
+	Actual  time.Duration // Time logged
+	Target  time.Duration // Time the schedule expects, less days off
+	Balance time.Duration // Running balance at the end of the week
+}
+
+// Overtime is the week's time over its target, negative when short of it
+func (w Week) Overtime() time.Duration {
+	return w.Actual - w.Target
+}
+
+// Ledger is the overtime balance over the days from Start up to End
+type Ledger struct {
+	Start  time.Time
+	End    time.Time
+	Actual time.Duration
+	Target time.Duration
+	Weeks  []Week
+}
+
+// Balance is the time logged over the period's target, negative when behind
+func (l Ledger) Balance() time.Duration {
+	return l.Actual - l.Target
+}
+
+// Compute builds the ledger for the local days from start up to end
+// Overnight entries count toward each day they cover, and only the part of
+// an entry inside the period counts.
+func Compute(entries []models.Entry, schedule config.Schedule, daysOff holidays.Calendar, start, end time.Time) Ledger {
+	start = localDate(start)
+	end = localDate(end)
+	ledger := Ledger{Start: start, End: end}
+
+	logged := make(map[string]time.Duration)
+	for _, entry := range entries {
+		for _, day := range entry.Days(time.Local) {
+			if !day.Before(start) && day.Before(end) {
+				logged[day.Format("2006-01-02")] += entry.DurationOn(day)
+			}
+		}
+	}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if len(ledger.Weeks) == 0 || day.Equal(schedule.StartOfWeek(day)) {
+			ledger.Weeks = append(ledger.Weeks, Week{Start: day})
+		}
+		week := &ledger.Weeks[len(ledger.Weeks)-1]
+
+		actual := logged[day.Format("2006-01-02")]
+		target := daysOff.Target(schedule, day)
+		week.Actual += actual
+		week.Target += target
+		ledger.Actual += actual
+		ledger.Target += target
+		week.Balance = ledger.Balance()
+	}
+
+	return ledger
+}
+
+// Format shows a balance with its sign, like +3h 20m or -45m
+func Format(d time.Duration) string {
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	d = d.Round(time.Minute)
+
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%s%dm", sign, m)
+	case m == 0:
+		return fmt.Sprintf("%s%dh", sign, h)
+	}
+	return fmt.Sprintf("%s%dh %dm", sign, h, m)
+}
+
+// localDate returns local midnight on t's calendar date
+func localDate(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,79 @@
+package balance
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
+	"clockify-app/internal/models"
+	"testing"
+	"time"
+)
+
+func at(day, hour int) time.Time {
+	return time.Date(2026, time.October, day, hour, 0, 0, 0, time.Local)
+}
+
+func entryAt(start time.Time, length time.Duration) models.Entry {
+	return models.Entry{TimeInterval: models.IntervalTime{Start: start, End: start.Add(length)}}
+}
+
+func TestCompute(t *testing.T) {
+	entries := []models.Entry{
+		entryAt(at(1, 22), 4*time.Hour), // Thursday night, only the part after midnight on the 2nd counts
+		entryAt(at(2, 9), 6*time.Hour),
+		entryAt(at(5, 9), 9*time.Hour),
+		entryAt(at(6, 9), 8*time.Hour),
+		entryAt(at(10, 10), 2*time.Hour), // Saturday counts toward the week without a target
+		entryAt(at(13, 9), 8*time.Hour),
+	}
+	schedule := config.Schedule{WeekStart: "monday"}
+	daysOff := holidays.NewCalendar([]models.DayOff{{Date: at(12, 0), Name: "Columbus Day"}})
+
+	// Friday the 2nd up to Wednesday the 14th, which hasn't been logged yet
+	ledger := Compute(entries, schedule, daysOff, at(2, 0), at(15, 0))
+
+	if len(ledger.Weeks) != 3 {
+		t.Fatalf("Expected a partial first week and two full weeks, got %d", len(ledger.Weeks))
+	}
+	tests := []struct {
+		start   time.Time
+		actual  time.Duration
+		target  time.Duration
+		balance time.Duration
+	}{
+		{at(2, 0), 8 * time.Hour, 8 * time.Hour, 0},
+		{at(5, 0), 19 * time.Hour, 40 * time.Hour, -21 * time.Hour},
+		{at(12, 0), 8 * time.Hour, 16 * time.Hour, -29 * time.Hour},
+	}
+	for i, tt := range tests {
+		week := ledger.Weeks[i]
+		if !week.Start.Equal(tt.start) || week.Actual != tt.actual || week.Target != tt.target || week.Balance != tt.balance {
+			t.Errorf("Week %d: expected %v %v/%v balance %v, got %v %v/%v balance %v", i,
+				tt.start.Format("01/02"), tt.actual, tt.target, tt.balance,
+				week.Start.Format("01/02"), week.Actual, week.Target, week.Balance)
+		}
+	}
+
+	if ledger.Actual != 35*time.Hour || ledger.Target != 64*time.Hour || ledger.Balance() != -29*time.Hour {
+		t.Errorf("Expected 35h of 64h, got %v of %v", ledger.Actual, ledger.Target)
+	}
+	if overtime := ledger.Weeks[1].Overtime(); overtime != -21*time.Hour {
+		t.Errorf("Expected the second week 21h short, got %v", overtime)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                              "+0m",
+		45 * time.Minute:               "+45m",
+		3*time.Hour + 20*time.Minute:   "+3h 20m",
+		-8 * time.Hour:                 "-8h",
+		-(time.Hour + 29*time.Second):  "-1h",
+		-(26*time.Hour + time.Minute):  "-26h 1m",
+		125*time.Hour + 30*time.Second: "+125h 1m",
+	}
+	for d, want := range tests {
+		if got := Format(d); got != want {
+			t.Errorf("Format(%v): expected %q, got %q", d, want, got)
+		}
+	}
+}
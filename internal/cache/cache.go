@@ -2,6 +2,9 @@ package cache
 
 import (
 	"clockify-app/internal/models"
+	"clockify-app/internal/storage"
+	"encoding/json"
+	"errors"
 	"sync"
 	"time"
 )
@@ -13,15 +16,42 @@ var (
 
 var minTilExpired = 2 * time.Minute
 
+// Keys the cache is persisted under
+const (
+	entriesKey  = "entries"
+	projectsKey = "projects"
+	tagsKey     = "tags"
+	tasksKey    = "tasks"
+)
+
 type ClockifyCache struct {
 	mu sync.RWMutex
 
 	// Cache for entries and projects
 	Entries  CachedItem[[]models.Entry]
 	Projects CachedItem[[]models.Project]
+	Tags     CachedItem[[]models.Tag]
 
 	// Cache for project tasks (loaded on demand)
 	ProjectTasks map[string]CachedItem[[]models.Task]
+
+	// Where changes are written through to, nil to keep the cache in memory
+	store       *storage.Store
+	workspaceID string
+
+	// Writes to the store are queued under mu and run by unlock once it's released.
+	// Each is numbered so a slow write can't land over a newer one for the same record.
+	pending []storeWrite
+	seq     uint64
+	writeMu sync.Mutex
+	written map[string]uint64 // Latest write to reach each record, under writeMu
+}
+
+// storeWrite is a change to a stored record, prepared under the lock
+type storeWrite struct {
+	record string // Workspace and key
+	seq    uint64
+	run    func() error
 }
 
 type CachedItem[T any] struct {
@@ -42,43 +72,179 @@ func (c *ClockifyCache) Clear() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.clear()
+}
+
+func (c *ClockifyCache) clear() {
 	c.Entries = CachedItem[[]models.Entry]{}
 	c.Projects = CachedItem[[]models.Project]{}
+	c.Tags = CachedItem[[]models.Tag]{}
 	c.ProjectTasks = make(map[string]CachedItem[[]models.Task])
 }
 
+// Attach persists the cache to store for a workspace, replacing what's in memory
+// with what was stored for it last time. Stored data is served stale, so it's
+// shown straight away and revalidated, since Clockify may have changed since.
+// A nil store keeps the cache in memory only.
+func (c *ClockifyCache) Attach(store *storage.Store, workspaceID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clear()
+	c.store = nil
+	c.workspaceID = ""
+	if store == nil || workspaceID == "" {
+		return nil
+	}
+	c.store = store
+	c.workspaceID = workspaceID
+
+	// A missing or unreadable record leaves that part of the cache cold
+	var errs []error
+	var tasks map[string][]models.Task
+	c.Entries.Data, errs = load[[]models.Entry](store, workspaceID, entriesKey, errs)
+	c.Projects.Data, errs = load[[]models.Project](store, workspaceID, projectsKey, errs)
+	c.Tags.Data, errs = load[[]models.Tag](store, workspaceID, tagsKey, errs)
+	tasks, errs = load[map[string][]models.Task](store, workspaceID, tasksKey, errs)
+	for projectID, projectTasks := range tasks {
+		c.ProjectTasks[projectID] = CachedItem[[]models.Task]{Data: projectTasks}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WorkspaceID returns the workspace the cache is persisted for, empty when it's memory only
+func (c *ClockifyCache) WorkspaceID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.workspaceID
+}
+
+// load reads a stored record, adding any error but a missing record to errs
+func load[T any](store *storage.Store, workspaceID, key string, errs []error) (T, []error) {
+	var v T
+	if err := store.Load(workspaceID, key, &v); err != nil {
+		var zero T
+		if !errors.Is(err, storage.ErrNotFound) {
+			errs = append(errs, err)
+		}
+		return zero, errs
+	}
+	return v, errs
+}
+
+// persist queues data to be written through to the store once the lock is released.
+// It's encoded now, so later changes to the cache don't race with the write.
+// Saving is best effort: the cache still works in memory when the disk doesn't.
+func (c *ClockifyCache) persist(key string, data any) {
+	if c.store == nil || c.workspaceID == "" {
+		return
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	store, workspaceID := c.store, c.workspaceID
+	c.queue(key, func() error {
+		return store.Save(workspaceID, key, json.RawMessage(encoded))
+	})
+}
+
+// forget queues a key's removal from the store, so the next launch starts cold for it
+func (c *ClockifyCache) forget(key string) {
+	if c.store == nil || c.workspaceID == "" {
+		return
+	}
+	store, workspaceID := c.store, c.workspaceID
+	c.queue(key, func() error {
+		return store.Delete(workspaceID, key)
+	})
+}
+
+// queue adds a write for unlock to run, called with the lock held
+func (c *ClockifyCache) queue(key string, run func() error) {
+	c.seq++
+	c.pending = append(c.pending, storeWrite{record: c.workspaceID + "/" + key, seq: c.seq, run: run})
+}
+
+// unlock releases the lock, then runs the writes queued while it was held
+func (c *ClockifyCache) unlock() {
+	writes := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	if len(writes) == 0 {
+		return
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.written == nil {
+		c.written = make(map[string]uint64)
+	}
+	for _, w := range writes {
+		// Another caller already wrote a newer version of this record
+		if c.written[w.record] > w.seq {
+			continue
+		}
+		c.written[w.record] = w.seq
+		_ = w.run()
+	}
+}
+
+// fresh reports whether an item was cached recently enough to skip revalidating it
+func fresh[T any](item CachedItem[T]) bool {
+	return time.Since(item.CachedAt) < minTilExpired
+}
+
 // ================================
 // Entries Cache Methods
 // ================================
 
 func (c *ClockifyCache) SetEntries(entries []models.Entry) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	c.Entries = CachedItem[[]models.Entry]{
 		Data:     entries,
 		CachedAt: time.Now(),
 	}
+	c.persist(entriesKey, entries)
 }
 
 func (c *ClockifyCache) AddEntry(entry models.Entry) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
+
+	// Only change a fresh list, marking a stale one fresh would skip its revalidation
+	if !fresh(c.Entries) {
+		c.Entries = CachedItem[[]models.Entry]{}
+		c.forget(entriesKey)
+		return
+	}
 
 	// Prepend the new entry into the cached entries
 	c.Entries.Data = append([]models.Entry{entry}, c.Entries.Data...)
-	c.Entries.CachedAt = time.Now()
+	c.persist(entriesKey, c.Entries.Data)
 }
 
 func (c *ClockifyCache) UpdateEntry(updatedEntry models.Entry) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
+
+	if !fresh(c.Entries) {
+		c.Entries = CachedItem[[]models.Entry]{}
+		c.forget(entriesKey)
+		return
+	}
 
 	// Find and update the entry in the cached entries
 	for i, entry := range c.Entries.Data {
 		if entry.ID == updatedEntry.ID {
 			c.Entries.Data[i] = updatedEntry
-			c.Entries.CachedAt = time.Now()
+			c.persist(entriesKey, c.Entries.Data)
 			return
 		}
 	}
@@ -86,13 +252,19 @@ func (c *ClockifyCache) UpdateEntry(updatedEntry models.Entry) {
 
 func (c *ClockifyCache) DeleteEntry(entryID string) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
+
+	if !fresh(c.Entries) {
+		c.Entries = CachedItem[[]models.Entry]{}
+		c.forget(entriesKey)
+		return
+	}
 
 	// Find and remove the entry from the cached Entries
 	for i, entry := range c.Entries.Data {
 		if entry.ID == entryID {
 			c.Entries.Data = append(c.Entries.Data[:i], c.Entries.Data[i+1:]...)
-			c.Entries.CachedAt = time.Now()
+			c.persist(entriesKey, c.Entries.Data)
 			return
 		}
 	}
@@ -100,9 +272,10 @@ func (c *ClockifyCache) DeleteEntry(entryID string) {
 
 func (c *ClockifyCache) InvalidateEntries() {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	c.Entries = CachedItem[[]models.Entry]{}
+	c.forget(entriesKey)
 }
 
 func (c *ClockifyCache) GetEntries() []models.Entry {
@@ -110,7 +283,7 @@ func (c *ClockifyCache) GetEntries() []models.Entry {
 	defer c.mu.RUnlock()
 
 	if len(c.Entries.Data) > 0 {
-		if time.Since(c.Entries.CachedAt) < minTilExpired {
+		if fresh(c.Entries) {
 			return c.Entries.Data
 		}
 	}
@@ -118,34 +291,54 @@ func (c *ClockifyCache) GetEntries() []models.Entry {
 	return nil
 }
 
+// LookupEntries returns the cached entries however old they are, and whether they're still fresh
+func (c *ClockifyCache) LookupEntries() ([]models.Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.Entries.Data) == 0 {
+		return nil, false
+	}
+	return c.Entries.Data, fresh(c.Entries)
+}
+
 // ================================
 // Projects Cache Methods
 // ================================
 
 func (c *ClockifyCache) SetProjects(projects []models.Project) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	c.Projects = CachedItem[[]models.Project]{
 		Data:     projects,
 		CachedAt: time.Now(),
 	}
+	c.persist(projectsKey, projects)
 }
 
 func (c *ClockifyCache) AddProject(project models.Project) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
+
+	// Only extend a fresh list, marking a stale one fresh would skip its revalidation
+	if !fresh(c.Projects) {
+		c.Projects = CachedItem[[]models.Project]{}
+		c.forget(projectsKey)
+		return
+	}
 
 	// Append the new project into the cached projects
 	c.Projects.Data = append(c.Projects.Data, project)
-	c.Projects.CachedAt = time.Now()
+	c.persist(projectsKey, c.Projects.Data)
 }
 
 func (c *ClockifyCache) InvalidateProjects() {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	c.Projects = CachedItem[[]models.Project]{}
+	c.forget(projectsKey)
 }
 
 func (c *ClockifyCache) GetProjects() []models.Project {
@@ -153,7 +346,7 @@ func (c *ClockifyCache) GetProjects() []models.Project {
 	defer c.mu.RUnlock()
 
 	if len(c.Projects.Data) > 0 {
-		if time.Since(c.Projects.CachedAt) < minTilExpired {
+		if fresh(c.Projects) {
 			return c.Projects.Data
 		}
 	}
@@ -161,18 +354,101 @@ func (c *ClockifyCache) GetProjects() []models.Project {
 	return nil
 }
 
+// LookupProjects returns the cached projects however old they are, and whether they're still fresh
+func (c *ClockifyCache) LookupProjects() ([]models.Project, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.Projects.Data) == 0 {
+		return nil, false
+	}
+	return c.Projects.Data, fresh(c.Projects)
+}
+
+// ================================
+// Tags Cache Methods
+// ================================
+
+func (c *ClockifyCache) SetTags(tags []models.Tag) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	c.Tags = CachedItem[[]models.Tag]{
+		Data:     tags,
+		CachedAt: time.Now(),
+	}
+	c.persist(tagsKey, tags)
+}
+
+func (c *ClockifyCache) AddTag(tag models.Tag) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	// Only extend a fresh list, a stale or empty one would hide the tags not yet loaded
+	if !fresh(c.Tags) {
+		c.Tags = CachedItem[[]models.Tag]{}
+		c.forget(tagsKey)
+		return
+	}
+
+	c.Tags.Data = append(c.Tags.Data, tag)
+	c.persist(tagsKey, c.Tags.Data)
+}
+
+func (c *ClockifyCache) InvalidateTags() {
+	c.mu.Lock()
+	defer c.unlock()
+
+	c.Tags = CachedItem[[]models.Tag]{}
+	c.forget(tagsKey)
+}
+
+func (c *ClockifyCache) GetTags() []models.Tag {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.Tags.Data) > 0 {
+		if fresh(c.Tags) {
+			return c.Tags.Data
+		}
+	}
+
+	return nil
+}
+
+// LookupTags returns the cached tags however old they are, and whether they're still fresh
+func (c *ClockifyCache) LookupTags() ([]models.Tag, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if len(c.Tags.Data) == 0 {
+		return nil, false
+	}
+	return c.Tags.Data, fresh(c.Tags)
+}
+
 // ================================
 // Project Tasks Cache Methods
 // ================================
 
 func (c *ClockifyCache) SetProjectTasks(projectID string, tasks []models.Task) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	c.ProjectTasks[projectID] = CachedItem[[]models.Task]{
 		Data:     tasks,
 		CachedAt: time.Now(),
 	}
+	c.persistTasks()
+}
+
+// persistTasks writes every project's tasks through to the store as one record
+func (c *ClockifyCache) persistTasks() {
+	tasks := make(map[string][]models.Task, len(c.ProjectTasks))
+	for projectID, item := range c.ProjectTasks {
+		tasks[projectID] = item.Data
+	}
+	c.persist(tasksKey, tasks)
 }
 
 func (c *ClockifyCache) GetProjectTasks(projectID string) []models.Task {
@@ -181,7 +457,7 @@ func (c *ClockifyCache) GetProjectTasks(projectID string) []models.Task {
 
 	if item, exists := c.ProjectTasks[projectID]; exists {
 		// Check if expired (5 minutes)
-		if time.Since(item.CachedAt) < minTilExpired {
+		if fresh(item) {
 			return item.Data
 		}
 	}
@@ -189,10 +465,24 @@ func (c *ClockifyCache) GetProjectTasks(projectID string) []models.Task {
 	return nil
 }
 
+// LookupProjectTasks returns a project's cached tasks however old they are, whether
+// there were any cached, and whether they're still fresh
+// A project without tasks is cached as an empty list, so found tells it apart from a miss.
+func (c *ClockifyCache) LookupProjectTasks(projectID string) ([]models.Task, bool, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, exists := c.ProjectTasks[projectID]
+	if !exists {
+		return nil, false, false
+	}
+	return item.Data, true, fresh(item)
+}
+
 func (c *ClockifyCache) InvalidateProjectTasks(projectID string) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	defer c.unlock()
 
 	delete(c.ProjectTasks, projectID)
-	delete(c.ProjectTasks, projectID)
+	c.persistTasks()
 }
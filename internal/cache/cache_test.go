@@ -2,6 +2,7 @@ package cache
 
 import (
 	"clockify-app/internal/models"
+	"clockify-app/internal/storage"
 	"strconv"
 	"testing"
 	"time"
@@ -141,6 +142,37 @@ func TestProjectsCache(t *testing.T) {
 	}
 }
 
+func TestTagsCache(t *testing.T) {
+	cache := GetInstance()
+	cache.InvalidateTags()
+
+	// Adding to an empty cache must not make it look loaded
+	cache.AddTag(models.Tag{ID: "t0", Name: "Orphan"})
+	if tags := cache.GetTags(); tags != nil {
+		t.Errorf("Expected nil after adding to an empty cache, got %v", tags)
+	}
+
+	cache.SetTags([]models.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Development"},
+	})
+
+	cache.AddTag(models.Tag{ID: "t3", Name: "Support"})
+	retrieved := cache.GetTags()
+
+	if len(retrieved) != 3 {
+		t.Fatalf("Expected 3 tags after adding, got %d", len(retrieved))
+	}
+	if retrieved[2].ID != "t3" {
+		t.Error("New tag was not added correctly")
+	}
+
+	cache.InvalidateTags()
+	if cache.GetTags() != nil {
+		t.Error("Expected nil after invalidating tags")
+	}
+}
+
 // Test Project Tasks Cache
 func TestProjectTasksCache(t *testing.T) {
 	cache := GetInstance()
@@ -232,3 +264,88 @@ func TestConcurrentAccess(t *testing.T) {
 
 	// Test should complete without race conditions
 }
+
+func TestAttachPersists(t *testing.T) {
+	c := GetInstance()
+	store := storage.New(t.TempDir())
+	t.Cleanup(func() { _ = c.Attach(nil, "") })
+
+	if err := c.Attach(store, "ws1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	c.SetProjects([]models.Project{{ID: "p1", Name: "Website"}})
+	c.SetTags([]models.Tag{{ID: "t1", Name: "Meeting"}})
+	c.SetEntries([]models.Entry{{ID: "e1"}})
+	c.AddEntry(models.Entry{ID: "e2"})
+	c.SetProjectTasks("p1", []models.Task{{ID: "k1", Name: "Review"}})
+	c.InvalidateTags()
+
+	// A new launch starts from what was stored, stale until revalidated
+	if err := c.Attach(store, "ws1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if c.GetProjects() != nil {
+		t.Error("Stored projects should be stale")
+	}
+	projects, fresh := c.LookupProjects()
+	if len(projects) != 1 || projects[0].Name != "Website" || fresh {
+		t.Errorf("Expected the stored projects, stale, got %+v (fresh %v)", projects, fresh)
+	}
+	if entries, _ := c.LookupEntries(); len(entries) != 2 || entries[0].ID != "e2" {
+		t.Errorf("Expected both stored entries, newest first, got %+v", entries)
+	}
+	if tasks, found, _ := c.LookupProjectTasks("p1"); !found || len(tasks) != 1 {
+		t.Errorf("Expected the project's stored tasks, got %+v", tasks)
+	}
+	if tags, _ := c.LookupTags(); tags != nil {
+		t.Errorf("Expected invalidated tags to stay gone, got %+v", tags)
+	}
+
+	// Changing stale data drops it rather than passing it off as fresh
+	c.AddEntry(models.Entry{ID: "e3"})
+	c.AddProject(models.Project{ID: "p2", Name: "Docs"})
+	if entries, _ := c.LookupEntries(); entries != nil {
+		t.Errorf("Expected stale entries to be dropped, got %+v", entries)
+	}
+	if projects, _ := c.LookupProjects(); projects != nil {
+		t.Errorf("Expected stale projects to be dropped, got %+v", projects)
+	}
+
+	// Another workspace starts cold
+	if err := c.Attach(store, "ws2"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if projects, _ := c.LookupProjects(); projects != nil {
+		t.Errorf("Expected nothing cached for another workspace, got %+v", projects)
+	}
+	if c.WorkspaceID() != "ws2" {
+		t.Errorf("Expected the cache to follow ws2, got %q", c.WorkspaceID())
+	}
+}
+
+func TestEditsKeepCachedAt(t *testing.T) {
+	c := GetInstance()
+	c.Clear()
+	t.Cleanup(c.Clear)
+
+	c.SetEntries([]models.Entry{{ID: "e1"}})
+	c.SetProjects([]models.Project{{ID: "p1"}})
+	entriesAt, projectsAt := c.Entries.CachedAt, c.Projects.CachedAt
+
+	// An edit changes the data, not how long ago it was fetched from Clockify
+	time.Sleep(time.Millisecond)
+	c.AddEntry(models.Entry{ID: "e2"})
+	c.UpdateEntry(models.Entry{ID: "e1", Description: "Edited"})
+	c.DeleteEntry("e2")
+	c.AddProject(models.Project{ID: "p2"})
+
+	if !c.Entries.CachedAt.Equal(entriesAt) {
+		t.Errorf("Expected entries cached at %v, got %v", entriesAt, c.Entries.CachedAt)
+	}
+	if !c.Projects.CachedAt.Equal(projectsAt) {
+		t.Errorf("Expected projects cached at %v, got %v", projectsAt, c.Projects.CachedAt)
+	}
+	if entries := c.GetEntries(); len(entries) != 1 || entries[0].Description != "Edited" {
+		t.Errorf("Expected the edited entry, got %+v", entries)
+	}
+}
@@ -2,15 +2,30 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 )
 
+// BaseURLEnv overrides the configured API base URL when set
+const BaseURLEnv = "CLOCKIFY_BASE_URL"
+
 type Config struct {
-	APIKey        string `json:"api_key"`
-	UserId        string `json:"user_id"`
-	WorkspaceId   string `json:"workspace_id"`
-	WorkspaceName string `json:"workspace_name"`
+	APIKey        string   `json:"api_key"`
+	UserId        string   `json:"user_id"`
+	WorkspaceId   string   `json:"workspace_id"`
+	WorkspaceName string   `json:"workspace_name"`
+	BaseURL       string   `json:"base_url,omitempty"` // Empty uses the public Clockify API
+	Schedule      Schedule `json:"schedule,omitzero"`  // Working week for targets, see Schedule
+}
+
+// APIBaseURL returns the API base URL to use, preferring the environment override.
+// An empty result means the default Clockify endpoint.
+func (c *Config) APIBaseURL() string {
+	if env := os.Getenv(BaseURLEnv); env != "" {
+		return env
+	}
+	return c.BaseURL
 }
 
 func LoadConfig() (*Config, error) {
@@ -28,6 +43,9 @@ func LoadConfig() (*Config, error) {
 	if err = json.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
+	if err = cfg.Schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid schedule in %s: %w", path, err)
+	}
 
 	return &cfg, nil
 }
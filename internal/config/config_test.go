@@ -81,3 +81,23 @@ func TestLoadConfigNotExists(t *testing.T) {
 		t.Error("LoadConfig should return empty config when file doesn't exist")
 	}
 }
+
+func TestAPIBaseURL(t *testing.T) {
+	t.Setenv(BaseURLEnv, "")
+
+	cfg := &Config{}
+	if cfg.APIBaseURL() != "" {
+		t.Errorf("Expected empty base URL by default, got %q", cfg.APIBaseURL())
+	}
+
+	cfg.BaseURL = "https://euc1.clockify.me/api/v1"
+	if cfg.APIBaseURL() != cfg.BaseURL {
+		t.Errorf("Expected configured base URL, got %q", cfg.APIBaseURL())
+	}
+
+	// The environment wins over the config file
+	t.Setenv(BaseURLEnv, "http://localhost:8080/api/v1")
+	if cfg.APIBaseURL() != "http://localhost:8080/api/v1" {
+		t.Errorf("Expected env base URL, got %q", cfg.APIBaseURL())
+	}
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultDailyHours is the target for a working day without its own hours
+const DefaultDailyHours = 8
+
+// Schedule is the user's working week, which sets the targets the week and month views compare against
+// Every field is optional; the zero value is a full-time Monday to Friday week starting on Sunday.
+type Schedule struct {
+	WeekStart   string             `json:"week_start,omitempty"`   // First day of the week, e.g. "monday"
+	WorkingDays []string           `json:"working_days,omitempty"` // Days with a target, Monday to Friday when empty
+	DailyHours  map[string]float64 `json:"daily_hours,omitempty"`  // Hours per working day, DefaultDailyHours when missing
+	PartTime    float64            `json:"part_time,omitempty"`    // Percentage of the targets to work, 0 for full time
+
+	// Days off, which have no target
+	Country      string `json:"country,omitempty"`       // Built-in public holidays, e.g. "US" or "DE"
+	HolidaysFile string `json:"holidays_file,omitempty"` // iCalendar (.ics) file of holidays or leave
+
+	// Overtime balance, counted up to today
+	BalanceSince string `json:"balance_since,omitempty"` // Date the balance starts from, e.g. "2026-03-01", January 1 when empty
+}
+
+// balanceDateLayout is how balance_since is written
+const balanceDateLayout = "2006-01-02"
+
+// Validate reports the first setting that doesn't name a weekday or a sensible amount
+func (s Schedule) Validate() error {
+	if s.WeekStart != "" {
+		if _, err := ParseWeekday(s.WeekStart); err != nil {
+			return fmt.Errorf("week_start: %w", err)
+		}
+	}
+	for _, day := range s.WorkingDays {
+		if _, err := ParseWeekday(day); err != nil {
+			return fmt.Errorf("working_days: %w", err)
+		}
+	}
+	for day, hours := range s.DailyHours {
+		if _, err := ParseWeekday(day); err != nil {
+			return fmt.Errorf("daily_hours: %w", err)
+		}
+		if hours < 0 || hours > 24 {
+			return fmt.Errorf("daily_hours: %s must be between 0 and 24 hours", day)
+		}
+	}
+	if s.PartTime < 0 || s.PartTime > 100 {
+		return fmt.Errorf("part_time: must be a percentage between 0 and 100")
+	}
+	if s.BalanceSince != "" {
+		if _, err := ParseDate(s.BalanceSince); err != nil {
+			return fmt.Errorf("balance_since: %w", err)
+		}
+	}
+	return nil
+}
+
+// FirstDay returns the day weeks start on
+func (s Schedule) FirstDay() time.Weekday {
+	if day, err := ParseWeekday(s.WeekStart); err == nil {
+		return day
+	}
+	return time.Sunday
+}
+
+// StartOfWeek returns midnight of the first day of the week containing t
+func (s Schedule) StartOfWeek(t time.Time) time.Time {
+	t = t.In(time.Local)
+	offset := (int(t.Weekday()) - int(s.FirstDay()) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.Local)
+}
+
+// WeekDays returns the seven weekdays in order, starting on the first day of the week
+func (s Schedule) WeekDays() []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = (s.FirstDay() + time.Weekday(i)) % 7
+	}
+	return days
+}
+
+// IsWorkingDay reports whether day has a target
+func (s Schedule) IsWorkingDay(day time.Weekday) bool {
+	if len(s.WorkingDays) == 0 {
+		return day != time.Saturday && day != time.Sunday
+	}
+	for _, name := range s.WorkingDays {
+		if working, err := ParseWeekday(name); err == nil && working == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Target returns the time expected on day, zero on days off
+func (s Schedule) Target(day time.Weekday) time.Duration {
+	if !s.IsWorkingDay(day) {
+		return 0
+	}
+
+	hours := float64(DefaultDailyHours)
+	for name, h := range s.DailyHours {
+		if d, err := ParseWeekday(name); err == nil && d == day {
+			hours = h
+		}
+	}
+	if s.PartTime > 0 {
+		hours *= s.PartTime / 100
+	}
+	return time.Duration(hours * float64(time.Hour)).Round(time.Minute)
+}
+
+// TargetBetween sums the targets of the days from start up to end
+func (s Schedule) TargetBetween(start, end time.Time) time.Duration {
+	var total time.Duration
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		total += s.Target(day.Weekday())
+	}
+	return total
+}
+
+// LongestDay returns the largest daily target, used to weigh time logged on days off
+func (s Schedule) LongestDay() time.Duration {
+	var longest time.Duration
+	for day := range time.Weekday(7) {
+		longest = max(longest, s.Target(day))
+	}
+	return longest
+}
+
+// BalanceStart returns midnight of the day the overtime balance counts from,
+// the start of now's year when balance_since isn't set
+func (s Schedule) BalanceStart(now time.Time) time.Time {
+	if day, err := ParseDate(s.BalanceSince); err == nil {
+		return day
+	}
+	now = now.In(time.Local)
+	return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.Local)
+}
+
+// ParseDate reads a YYYY-MM-DD date as local midnight
+func ParseDate(value string) (time.Time, error) {
+	day, err := time.ParseInLocation(balanceDateLayout, strings.TrimSpace(value), time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a YYYY-MM-DD date", value)
+	}
+	return day, nil
+}
+
+// ParseWeekday reads a weekday name in any case, full ("monday") or abbreviated ("mon")
+func ParseWeekday(name string) (time.Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) >= 3 {
+		for day := range time.Weekday(7) {
+			if strings.HasPrefix(strings.ToLower(day.String()), name) {
+				return day, nil
+			}
+		}
+	}
+	return time.Sunday, fmt.Errorf("%q is not a weekday", name)
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDefaultSchedule(t *testing.T) {
+	var s Schedule
+
+	if s.FirstDay() != time.Sunday {
+		t.Errorf("Expected weeks to start on Sunday, got %v", s.FirstDay())
+	}
+	if s.Target(time.Monday) != 8*time.Hour || s.Target(time.Saturday) != 0 {
+		t.Errorf("Expected 8h weekdays and free weekends, got %v and %v", s.Target(time.Monday), s.Target(time.Saturday))
+	}
+
+	// Thursday, October 15 2026
+	day := time.Date(2026, 10, 15, 14, 30, 0, 0, time.Local)
+	if start := s.StartOfWeek(day); !start.Equal(time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Expected the week to start on Sunday the 11th, got %v", start)
+	}
+	if target := s.TargetBetween(s.StartOfWeek(day), s.StartOfWeek(day).AddDate(0, 0, 7)); target != 40*time.Hour {
+		t.Errorf("Expected a 40h week, got %v", target)
+	}
+}
+
+func TestPartTimeSchedule(t *testing.T) {
+	var s Schedule
+	data := `{"week_start": "Monday", "working_days": ["mon", "tue", "thurs"], "daily_hours": {"thu": 6}, "part_time": 80}`
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Validate(); err != nil {
+		t.Fatalf("Expected a valid schedule, got %v", err)
+	}
+
+	day := time.Date(2026, 10, 11, 9, 0, 0, 0, time.Local) // Sunday
+	if start := s.StartOfWeek(day); !start.Equal(time.Date(2026, 10, 5, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Expected Sunday to close the week from Monday the 5th, got %v", start)
+	}
+	if days := s.WeekDays(); days[0] != time.Monday || days[6] != time.Sunday {
+		t.Errorf("Expected Monday to Sunday, got %v", days)
+	}
+
+	tests := []struct {
+		day    time.Weekday
+		target time.Duration
+	}{
+		{time.Monday, 6*time.Hour + 24*time.Minute},
+		{time.Wednesday, 0},
+		{time.Thursday, 4*time.Hour + 48*time.Minute},
+	}
+	for _, test := range tests {
+		if got := s.Target(test.day); got != test.target {
+			t.Errorf("Target(%v) = %v; want %v", test.day, got, test.target)
+		}
+	}
+	if s.LongestDay() != 6*time.Hour+24*time.Minute {
+		t.Errorf("Expected the longest day to be 6h24m, got %v", s.LongestDay())
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+	}{
+		{"week start", Schedule{WeekStart: "someday"}},
+		{"working day", Schedule{WorkingDays: []string{"mon", "fr"}}},
+		{"daily hours day", Schedule{DailyHours: map[string]float64{"weekend": 2}}},
+		{"daily hours amount", Schedule{DailyHours: map[string]float64{"mon": 25}}},
+		{"part time", Schedule{PartTime: 120}},
+		{"balance since", Schedule{BalanceSince: "03/01/2026"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.schedule.Validate(); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestBalanceStart(t *testing.T) {
+	now := time.Date(2026, 10, 15, 14, 30, 0, 0, time.Local)
+
+	var s Schedule
+	if start := s.BalanceStart(now); !start.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Expected the balance to start on January 1, got %v", start)
+	}
+
+	s.BalanceSince = "2026-03-02"
+	if err := s.Validate(); err != nil {
+		t.Fatalf("Expected a valid schedule, got %v", err)
+	}
+	if start := s.BalanceStart(now); !start.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Expected the balance to start on March 2, got %v", start)
+	}
+}
@@ -0,0 +1,221 @@
+package holidays
+
+import (
+	"clockify-app/internal/models"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// holiday is one rule of a country's list, returning its date in a year
+type holiday struct {
+	name string
+	date func(year int) time.Time
+}
+
+// countries holds the national public holidays of each supported country
+// Regional holidays are left out; use a holidays file for those.
+var countries = map[string][]holiday{
+	"US": {
+		{"New Year's Day", observed(fixed(time.January, 1))},
+		{"Martin Luther King Jr. Day", nthWeekday(time.January, time.Monday, 3)},
+		{"Presidents' Day", nthWeekday(time.February, time.Monday, 3)},
+		{"Memorial Day", nthWeekday(time.May, time.Monday, -1)},
+		{"Juneteenth", observed(fixed(time.June, 19))},
+		{"Independence Day", observed(fixed(time.July, 4))},
+		{"Labor Day", nthWeekday(time.September, time.Monday, 1)},
+		{"Columbus Day", nthWeekday(time.October, time.Monday, 2)},
+		{"Veterans Day", observed(fixed(time.November, 11))},
+		{"Thanksgiving Day", nthWeekday(time.November, time.Thursday, 4)},
+		{"Christmas Day", observed(fixed(time.December, 25))},
+	},
+	"GB": {
+		{"New Year's Day", substitute(0, fixed(time.January, 1))},
+		{"Good Friday", easter(-2)},
+		{"Easter Monday", easter(1)},
+		{"Early May Bank Holiday", nthWeekday(time.May, time.Monday, 1)},
+		{"Spring Bank Holiday", nthWeekday(time.May, time.Monday, -1)},
+		{"Summer Bank Holiday", nthWeekday(time.August, time.Monday, -1)},
+		{"Christmas Day", substitute(0, fixed(time.December, 25), fixed(time.December, 26))},
+		{"Boxing Day", substitute(1, fixed(time.December, 25), fixed(time.December, 26))},
+	},
+	"DE": {
+		{"Neujahr", fixed(time.January, 1)},
+		{"Karfreitag", easter(-2)},
+		{"Ostermontag", easter(1)},
+		{"Tag der Arbeit", fixed(time.May, 1)},
+		{"Christi Himmelfahrt", easter(39)},
+		{"Pfingstmontag", easter(50)},
+		{"Tag der Deutschen Einheit", fixed(time.October, 3)},
+		{"1. Weihnachtstag", fixed(time.December, 25)},
+		{"2. Weihnachtstag", fixed(time.December, 26)},
+	},
+	"FR": {
+		{"Jour de l'an", fixed(time.January, 1)},
+		{"Lundi de Pâques", easter(1)},
+		{"Fête du Travail", fixed(time.May, 1)},
+		{"Victoire 1945", fixed(time.May, 8)},
+		{"Ascension", easter(39)},
+		{"Lundi de Pentecôte", easter(50)},
+		{"Fête nationale", fixed(time.July, 14)},
+		{"Assomption", fixed(time.August, 15)},
+		{"Toussaint", fixed(time.November, 1)},
+		{"Armistice 1918", fixed(time.November, 11)},
+		{"Noël", fixed(time.December, 25)},
+	},
+	"NL": {
+		{"Nieuwjaarsdag", fixed(time.January, 1)},
+		{"Tweede Paasdag", easter(1)},
+		{"Koningsdag", kingsDay},
+		{"Hemelvaartsdag", easter(39)},
+		{"Tweede Pinksterdag", easter(50)},
+		{"Eerste Kerstdag", fixed(time.December, 25)},
+		{"Tweede Kerstdag", fixed(time.December, 26)},
+	},
+	"CA": {
+		{"New Year's Day", observed(fixed(time.January, 1))},
+		{"Good Friday", easter(-2)},
+		{"Victoria Day", victoriaDay},
+		{"Canada Day", observed(fixed(time.July, 1))},
+		{"Labour Day", nthWeekday(time.September, time.Monday, 1)},
+		{"Thanksgiving", nthWeekday(time.October, time.Monday, 2)},
+		{"Christmas Day", substitute(0, fixed(time.December, 25), fixed(time.December, 26))},
+		{"Boxing Day", substitute(1, fixed(time.December, 25), fixed(time.December, 26))},
+	},
+}
+
+// Countries returns the codes of the countries with a built-in list
+func Countries() []string {
+	var codes []string
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Builtin returns a country's public holidays in year
+// Countries are ISO 3166 codes, with UK accepted for GB.
+func Builtin(country string, year int) ([]models.DayOff, error) {
+	code := strings.ToUpper(strings.TrimSpace(country))
+	if code == "UK" {
+		code = "GB"
+	}
+	rules, ok := countries[code]
+	if !ok {
+		return nil, fmt.Errorf("no built-in holidays for %q, use one of %s or a holidays file", country, strings.Join(Countries(), ", "))
+	}
+
+	days := make([]models.DayOff, 0, len(rules))
+	for _, rule := range rules {
+		days = append(days, models.DayOff{Date: rule.date(year), Name: rule.name})
+	}
+	return days, nil
+}
+
+func fixed(month time.Month, day int) func(int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+}
+
+// nthWeekday returns the nth weekday of the month, counting from the end when n is negative
+func nthWeekday(month time.Month, weekday time.Weekday, n int) func(int) time.Time {
+	return func(year int) time.Time {
+		if n < 0 {
+			last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.Local)
+			offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+			return last.AddDate(0, 0, -offset+(n+1)*7)
+		}
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+		offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+		return first.AddDate(0, 0, offset+(n-1)*7)
+	}
+}
+
+// observed moves a holiday on Saturday to Friday and on Sunday to Monday
+func observed(date func(int) time.Time) func(int) time.Time {
+	return func(year int) time.Time {
+		day := date(year)
+		switch day.Weekday() {
+		case time.Saturday:
+			return day.AddDate(0, 0, -1)
+		case time.Sunday:
+			return day.AddDate(0, 0, 1)
+		}
+		return day
+	}
+}
+
+// substitute returns the index-th holiday of a run like Christmas and Boxing Day,
+// moving it to the next weekday no other holiday of the run has when it falls on a weekend
+func substitute(index int, run ...func(int) time.Time) func(int) time.Time {
+	return func(year int) time.Time {
+		dates := make([]time.Time, len(run))
+		taken := make(map[string]bool)
+		for i, date := range run {
+			dates[i] = date(year)
+			if !weekend(dates[i]) {
+				taken[dates[i].Format("2006-01-02")] = true
+			}
+		}
+
+		for i, day := range dates {
+			if !weekend(day) {
+				continue
+			}
+			for weekend(day) || taken[day.Format("2006-01-02")] {
+				day = day.AddDate(0, 0, 1)
+			}
+			taken[day.Format("2006-01-02")] = true
+			dates[i] = day
+		}
+		return dates[index]
+	}
+}
+
+func weekend(day time.Time) bool {
+	return day.Weekday() == time.Saturday || day.Weekday() == time.Sunday
+}
+
+// easter returns the date offset days from Easter Sunday
+func easter(offset int) func(int) time.Time {
+	return func(year int) time.Time {
+		return easterSunday(year).AddDate(0, 0, offset)
+	}
+}
+
+// easterSunday computes Western Easter with the anonymous Gregorian algorithm
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+}
+
+// kingsDay is April 27, or the 26th when the 27th is a Sunday
+func kingsDay(year int) time.Time {
+	day := time.Date(year, time.April, 27, 0, 0, 0, 0, time.Local)
+	if day.Weekday() == time.Sunday {
+		return day.AddDate(0, 0, -1)
+	}
+	return day
+}
+
+// victoriaDay is the last Monday before May 25
+func victoriaDay(year int) time.Time {
+	day := time.Date(year, time.May, 24, 0, 0, 0, 0, time.Local)
+	return day.AddDate(0, 0, -((int(day.Weekday()) - int(time.Monday) + 7) % 7))
+}
@@ -0,0 +1,128 @@
+// Package holidays finds the days off that lower work targets: public
+// holidays from a built-in country list or an iCalendar file, and leave.
+package holidays
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/models"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Calendar holds days off by date
+type Calendar map[string]models.DayOff
+
+// NewCalendar collects days off, a full day winning over half a day on the same date
+func NewCalendar(days []models.DayOff) Calendar {
+	c := make(Calendar, len(days))
+	for _, day := range days {
+		key := day.Date.Format("2006-01-02")
+		if existing, ok := c[key]; ok && !existing.Half {
+			continue
+		}
+		c[key] = day
+	}
+	return c
+}
+
+// On returns the day off on day's date, if there is one
+func (c Calendar) On(day time.Time) (models.DayOff, bool) {
+	off, ok := c[day.Format("2006-01-02")]
+	return off, ok
+}
+
+// Target returns the schedule's target for day, less any time off on it
+func (c Calendar) Target(schedule config.Schedule, day time.Time) time.Duration {
+	target := schedule.Target(day.Weekday())
+	if off, ok := c.On(day); ok {
+		if off.Half {
+			return target / 2
+		}
+		return 0
+	}
+	return target
+}
+
+// TargetBetween sums the targets of the days from start up to end
+func (c Calendar) TargetBetween(schedule config.Schedule, start, end time.Time) time.Duration {
+	var total time.Duration
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		total += c.Target(schedule, day)
+	}
+	return total
+}
+
+// Between returns the days off from start up to end in date order
+func (c Calendar) Between(start, end time.Time) []models.DayOff {
+	var days []models.DayOff
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if off, ok := c.On(day); ok {
+			days = append(days, off)
+		}
+	}
+	return days
+}
+
+// Local returns the days off from the schedule's country and holidays file
+// between the local dates of start and end
+func Local(schedule config.Schedule, start, end time.Time) ([]models.DayOff, error) {
+	start = localDate(start)
+	end = localDate(end)
+
+	var days []models.DayOff
+	if schedule.Country != "" {
+		for year := start.Year(); year <= end.Year(); year++ {
+			builtin, err := Builtin(schedule.Country, year)
+			if err != nil {
+				return nil, err
+			}
+			days = append(days, within(builtin, start, end)...)
+		}
+	}
+
+	if schedule.HolidaysFile != "" {
+		file, err := os.Open(expandHome(schedule.HolidaysFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open holidays file: %w", err)
+		}
+		defer file.Close()
+
+		events, err := ParseICS(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read holidays file: %w", err)
+		}
+		for _, event := range events {
+			days = append(days, event.DaysOff(start, end)...)
+		}
+	}
+
+	return days, nil
+}
+
+// expandHome resolves a leading ~/ the way a shell would
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+func within(days []models.DayOff, start, end time.Time) []models.DayOff {
+	var result []models.DayOff
+	for _, day := range days {
+		if !day.Date.Before(start) && day.Date.Before(end) {
+			result = append(result, day)
+		}
+	}
+	return result
+}
+
+// localDate returns local midnight on t's calendar date, whatever t's location
+func localDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
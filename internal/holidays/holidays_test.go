@@ -0,0 +1,133 @@
+package holidays
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/models"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestBuiltin(t *testing.T) {
+	tests := []struct {
+		country string
+		year    int
+		name    string
+		want    time.Time
+	}{
+		{"US", 2026, "Thanksgiving Day", date(2026, time.November, 26)},
+		{"US", 2026, "Memorial Day", date(2026, time.May, 25)},
+		{"US", 2026, "Independence Day", date(2026, time.July, 3)}, // Observed on Friday
+		{"US", 2027, "Christmas Day", date(2027, time.December, 24)},
+		{"GB", 2026, "Good Friday", date(2026, time.April, 3)},
+		{"uk", 2027, "Christmas Day", date(2027, time.December, 27)},
+		{"GB", 2027, "Boxing Day", date(2027, time.December, 28)},
+		{"GB", 2022, "Boxing Day", date(2022, time.December, 26)}, // Christmas moves past it
+		{"GB", 2022, "Christmas Day", date(2022, time.December, 27)},
+		{"DE", 2026, "Christi Himmelfahrt", date(2026, time.May, 14)},
+		{"NL", 2025, "Koningsdag", date(2025, time.April, 26)},
+		{"CA", 2026, "Victoria Day", date(2026, time.May, 18)},
+	}
+
+	for _, tt := range tests {
+		days, err := Builtin(tt.country, tt.year)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", tt.country, err)
+		}
+		var found bool
+		for _, day := range days {
+			if day.Name == tt.name {
+				found = true
+				if !day.Date.Equal(tt.want) {
+					t.Errorf("%s %s %d: expected %s, got %s", tt.country, tt.name, tt.year,
+						tt.want.Format("2006-01-02"), day.Date.Format("2006-01-02"))
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s has no %s", tt.country, tt.name)
+		}
+	}
+}
+
+func TestBuiltinUnknownCountry(t *testing.T) {
+	if _, err := Builtin("XX", 2026); err == nil {
+		t.Error("Expected an error for a country without a list")
+	}
+}
+
+func TestEasterSunday(t *testing.T) {
+	for year, want := range map[int]time.Time{
+		2024: date(2024, time.March, 31),
+		2025: date(2025, time.April, 20),
+		2026: date(2026, time.April, 5),
+		2038: date(2038, time.April, 25),
+	} {
+		if got := easterSunday(year); !got.Equal(want) {
+			t.Errorf("Easter %d: expected %s, got %s", year, want.Format("01/02"), got.Format("01/02"))
+		}
+	}
+}
+
+func TestCalendarTarget(t *testing.T) {
+	calendar := NewCalendar([]models.DayOff{
+		{Date: date(2026, time.December, 24), Name: "Christmas Eve", Half: true},
+		{Date: date(2026, time.December, 25), Name: "Leave", Half: true},
+		{Date: date(2026, time.December, 25), Name: "Christmas Day"},
+	})
+	schedule := config.Schedule{}
+
+	if got := calendar.Target(schedule, date(2026, time.December, 24)); got != 4*time.Hour {
+		t.Errorf("Expected a half day to halve the target, got %v", got)
+	}
+	if got := calendar.Target(schedule, date(2026, time.December, 25)); got != 0 {
+		t.Errorf("Expected the full holiday to win over half a day of leave, got %v", got)
+	}
+	if off, _ := calendar.On(date(2026, time.December, 25)); off.Name != "Christmas Day" {
+		t.Errorf("Expected Christmas Day, got %q", off.Name)
+	}
+
+	// Monday 21st to Sunday 27th: three full days, half a day and a holiday
+	week := date(2026, time.December, 21)
+	if got := calendar.TargetBetween(schedule, week, week.AddDate(0, 0, 7)); got != 28*time.Hour {
+		t.Errorf("Expected 28h for the week, got %v", got)
+	}
+	if days := calendar.Between(week, week.AddDate(0, 0, 7)); len(days) != 2 || days[0].Name != "Christmas Eve" {
+		t.Errorf("Expected the two days off in date order, got %v", days)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "leave.ics")
+	ics := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:Ski trip\r\n" +
+		"DTSTART;VALUE=DATE:20260216\r\nDTEND;VALUE=DATE:20260218\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	if err := os.WriteFile(file, []byte(ics), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	schedule := config.Schedule{Country: "US", HolidaysFile: file}
+	days, err := Local(schedule, date(2026, time.February, 1), date(2026, time.March, 1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	calendar := NewCalendar(days)
+	for day, want := range map[int]string{16: "Presidents' Day", 17: "Ski trip"} {
+		if off, ok := calendar.On(date(2026, time.February, day)); !ok || off.Name != want {
+			t.Errorf("Expected %s on February %d, got %q", want, day, off.Name)
+		}
+	}
+	if len(days) != 3 {
+		t.Errorf("Expected a holiday and two days of leave, got %v", days)
+	}
+
+	schedule.HolidaysFile = filepath.Join(t.TempDir(), "missing.ics")
+	if _, err := Local(schedule, date(2026, time.February, 1), date(2026, time.March, 1)); err == nil {
+		t.Error("Expected a missing holidays file to be reported")
+	}
+}
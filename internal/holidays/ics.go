@@ -0,0 +1,147 @@
+package holidays
+
+import (
+	"bufio"
+	"clockify-app/internal/models"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Event is an all-day or timed event from an iCalendar file
+type Event struct {
+	Name   string
+	Start  time.Time // Local midnight of the first day
+	End    time.Time // Local midnight after the last day
+	Yearly bool      // Repeats every year (RRULE:FREQ=YEARLY)
+}
+
+// DaysOff returns the dates the event covers from start up to end
+func (e Event) DaysOff(start, end time.Time) []models.DayOff {
+	years := []int{e.Start.Year()}
+	if e.Yearly {
+		years = nil
+		for year := max(e.Start.Year(), start.Year()-1); year <= end.Year(); year++ {
+			years = append(years, year)
+		}
+	}
+
+	var days []models.DayOff
+	for _, year := range years {
+		shift := year - e.Start.Year()
+		for day := e.Start.AddDate(shift, 0, 0); day.Before(e.End.AddDate(shift, 0, 0)); day = day.AddDate(0, 0, 1) {
+			if !day.Before(start) && day.Before(end) {
+				days = append(days, models.DayOff{Date: day, Name: e.Name})
+			}
+		}
+	}
+	return days
+}
+
+// ParseICS reads the events of an iCalendar file, like the holiday calendars
+// most calendar apps export. Only yearly recurrence without further rules is
+// understood; other recurring events count once.
+func ParseICS(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var event *Event
+	var endSet bool
+	for i, line := range lines {
+		name, params, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = &Event{}
+			endSet = false
+		case name == "END" && value == "VEVENT":
+			if event == nil || event.Start.IsZero() {
+				return nil, fmt.Errorf("line %d: event without a start date", i+1)
+			}
+			if !endSet || !event.End.After(event.Start) {
+				event.End = event.Start.AddDate(0, 0, 1)
+			}
+			events = append(events, *event)
+			event = nil
+		case event == nil:
+			continue
+		case name == "SUMMARY":
+			event.Name = unescape(value)
+		case name == "DTSTART", name == "DTEND":
+			t, timed, err := parseDate(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			if name == "DTSTART" {
+				event.Start = localDate(t)
+				break
+			}
+			// A timed event covers the day it ends on, an all-day end is already exclusive
+			event.End = localDate(t)
+			if timed && t.After(event.End) {
+				event.End = event.End.AddDate(0, 0, 1)
+			}
+			endSet = true
+		case name == "RRULE":
+			event.Yearly = value == "FREQ=YEARLY" || value == "FREQ=YEARLY;INTERVAL=1"
+		}
+	}
+	return events, nil
+}
+
+// unfold joins the continuation lines iCalendar wraps long lines with
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty splits "DTSTART;VALUE=DATE:20261225" into its name, parameters and value
+func splitProperty(line string) (string, map[string]string, string) {
+	head, value, _ := strings.Cut(line, ":")
+	parts := strings.Split(head, ";")
+
+	params := make(map[string]string)
+	for _, param := range parts[1:] {
+		if key, val, ok := strings.Cut(param, "="); ok {
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseDate reads a DATE or DATE-TIME value, reporting whether it had a time
+func parseDate(params map[string]string, value string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, false, err
+	}
+
+	loc := time.Local
+	if tzid, ok := params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t.In(time.Local), true, err
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t.In(time.Local), true, err
+}
+
+func unescape(text string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(text)
+}
@@ -0,0 +1,67 @@
+package holidays
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20201225
+DTEND;VALUE=DATE:20201226
+RRULE:FREQ=YEARLY
+SUMMARY:Christmas\, observed
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20261102
+SUMMARY:Dentist and a 
+ long drive
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;TZID=Europe/Amsterdam:20261105T090000
+DTEND;TZID=Europe/Amsterdam:20261106T120000
+SUMMARY:Conference
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParseICS(t *testing.T) {
+	events, err := ParseICS(strings.NewReader(testCalendar))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	if events[0].Name != "Christmas, observed" || !events[0].Yearly {
+		t.Errorf("Expected a yearly, unescaped Christmas, got %+v", events[0])
+	}
+	if events[1].Name != "Dentist and a long drive" {
+		t.Errorf("Expected folded lines to be joined, got %q", events[1].Name)
+	}
+	if !events[1].End.Equal(events[1].Start.AddDate(0, 0, 1)) {
+		t.Errorf("Expected an event without an end to last a day, got %v to %v", events[1].Start, events[1].End)
+	}
+
+	start := date(2026, time.November, 1)
+	end := date(2027, time.January, 1)
+	var days []string
+	for _, event := range events {
+		for _, day := range event.DaysOff(start, end) {
+			days = append(days, day.Date.Format("01/02"))
+		}
+	}
+	want := "12/25 11/02 11/05 11/06"
+	if got := strings.Join(days, " "); got != want {
+		t.Errorf("Expected days off %s, got %s", want, got)
+	}
+}
+
+func TestParseICSWithoutStart(t *testing.T) {
+	if _, err := ParseICS(strings.NewReader("BEGIN:VEVENT\nSUMMARY:Nothing\nEND:VEVENT\n")); err == nil {
+		t.Error("Expected an event without a start to be rejected")
+	}
+}
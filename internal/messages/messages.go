@@ -1,8 +1,10 @@
 package messages
 
 import (
+	"clockify-app/internal/balance"
 	"clockify-app/internal/config"
 	"clockify-app/internal/models"
+	"time"
 )
 
 // =====================================
@@ -45,7 +47,8 @@ type ProjectSelectedMsg struct {
 }
 
 type TasksLoadedMsg struct {
-	Tasks []models.Task
+	ProjectID string
+	Tasks     []models.Task
 }
 
 type AllTasksLoadedMsg struct {
@@ -54,6 +57,48 @@ type AllTasksLoadedMsg struct {
 
 type EntriesLoadedMsg struct {
 	Entries []models.Entry
+
+	// Range that was requested, zero for the recent entries list
+	Start time.Time
+	End   time.Time
+}
+
+// ForRange reports whether the entries were fetched for exactly [start, end)
+func (m EntriesLoadedMsg) ForRange(start, end time.Time) bool {
+	return m.Start.Equal(start) && m.End.Equal(end)
+}
+
+// DaysOffLoadedMsg carries the holidays and time off in a view's range
+type DaysOffLoadedMsg struct {
+	Days []models.DayOff
+
+	// Range that was requested, the same one the view fetched entries for
+	Start time.Time
+	End   time.Time
+}
+
+// ForRange reports whether the days off are for the given range
+func (m DaysOffLoadedMsg) ForRange(start, end time.Time) bool {
+	return m.Start.Equal(start) && m.End.Equal(end)
+}
+
+// BalanceLoadedMsg carries the overtime balance up to today
+type BalanceLoadedMsg struct {
+	Ledger balance.Ledger
+}
+
+// DayEntriesLoadedMsg carries the entries around Date, for checking a new entry against them
+type DayEntriesLoadedMsg struct {
+	Date    time.Time
+	Entries []models.Entry
+}
+
+type TagsLoadedMsg struct {
+	Tags []models.Tag
+}
+
+type TagCreatedMsg struct {
+	Tag models.Tag
 }
 
 type WorkspacesLoadedMsg struct {
@@ -84,10 +129,47 @@ type EntryUpdatedMsg struct {
 	Entry models.Entry
 }
 
+// EntrySaveFailedMsg is sent when the entry form couldn't create or update its entry
+type EntrySaveFailedMsg struct {
+	Err error
+}
+
+// EntryTrimmedMsg is sent when an entry was shortened to make room for another one
+type EntryTrimmedMsg struct {
+	Entry models.Entry
+}
+
+// EntryCreateStartedMsg opens the entry form with a time range already filled in
+type EntryCreateStartedMsg struct {
+	Start     time.Time
+	End       time.Time
+	ProjectID string // Project to preselect, empty to leave the choice open
+}
+
 type EntryCopyStartedMsg struct {
 	Entry models.Entry
 }
 
+// =====================================
+// Timer messages
+// =====================================
+
+type RunningTimerLoadedMsg struct {
+	Entry *models.Entry // nil when no timer is running
+}
+
+type TimerStartedMsg struct {
+	Entry models.Entry
+}
+
+type TimerStoppedMsg struct {
+	Entry models.Entry
+}
+
+type TimerTickMsg struct {
+	Time time.Time
+}
+
 // =====================================
 // Modal messages
 // =====================================
@@ -114,3 +196,22 @@ type ErrorMsg struct {
 func (e ErrorMsg) Error() string {
 	return e.Err.Error()
 }
+
+// =====================================
+// Notification messages
+// =====================================
+
+type NotifyLevel int
+
+const (
+	NotifyInfo NotifyLevel = iota
+	NotifySuccess
+	NotifyWarning
+	NotifyError
+)
+
+// NotifyMsg asks the app to show a toast above the info bar
+type NotifyMsg struct {
+	Level NotifyLevel
+	Text  string
+}
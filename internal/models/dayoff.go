@@ -0,0 +1,100 @@
+package models
+
+import "time"
+
+// DayOff is a date without a work target, like a public holiday or approved leave
+type DayOff struct {
+	Date time.Time // Local midnight
+	Name string
+	Half bool // Half-day leave halves the target instead of clearing it
+}
+
+// Holiday is a workspace holiday from Clockify
+// Dates are plain calendar dates, both ends included.
+type Holiday struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	OccursAnnually bool   `json:"occursAnnually"`
+	DatePeriod     struct {
+		StartDate string `json:"startDate"`
+		EndDate   string `json:"endDate"`
+	} `json:"datePeriod"`
+}
+
+// DaysOff returns the holiday's dates from start up to end, repeated every year when it occurs annually
+func (h Holiday) DaysOff(start, end time.Time) []DayOff {
+	first, err := time.ParseInLocation("2006-01-02", h.DatePeriod.StartDate, time.Local)
+	if err != nil {
+		return nil
+	}
+	last, err := time.ParseInLocation("2006-01-02", h.DatePeriod.EndDate, time.Local)
+	if err != nil || last.Before(first) {
+		last = first
+	}
+
+	years := []int{first.Year()}
+	if h.OccursAnnually {
+		years = nil
+		for year := start.Year() - 1; year <= end.Year(); year++ {
+			years = append(years, year)
+		}
+	}
+
+	var days []DayOff
+	for _, year := range years {
+		shift := year - first.Year()
+		for day := first.AddDate(shift, 0, 0); !day.After(last.AddDate(shift, 0, 0)); day = day.AddDate(0, 0, 1) {
+			if !day.Before(start) && day.Before(end) {
+				days = append(days, DayOff{Date: day, Name: h.Name})
+			}
+		}
+	}
+	return days
+}
+
+// TimeOffRequest is a leave request from Clockify's time off feature
+type TimeOffRequest struct {
+	ID         string `json:"id"`
+	UserID     string `json:"userId"`
+	PolicyName string `json:"policyName"`
+	Status     struct {
+		StatusType string `json:"statusType"` // APPROVED, PENDING, REJECTED
+	} `json:"status"`
+	TimeOffPeriod struct {
+		IsHalfDay bool `json:"isHalfDay"`
+		Period    struct {
+			Start time.Time `json:"start"`
+			End   time.Time `json:"end"`
+		} `json:"period"`
+	} `json:"timeOffPeriod"`
+}
+
+// TimeOffRequests is the page Clockify returns when searching leave requests
+type TimeOffRequests struct {
+	Count    int              `json:"count"`
+	Requests []TimeOffRequest `json:"requests"`
+}
+
+// DaysOff returns the local dates the request covers
+// A period ending at midnight doesn't cover the day it ends on.
+func (r TimeOffRequest) DaysOff() []DayOff {
+	period := r.TimeOffPeriod.Period
+	if period.Start.IsZero() {
+		return nil
+	}
+
+	end := period.End
+	if !end.After(period.Start) {
+		end = period.Start.Add(time.Nanosecond)
+	}
+
+	var days []DayOff
+	for day := startOfDay(period.Start.In(time.Local)); day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, DayOff{
+			Date: day,
+			Name: r.PolicyName,
+			Half: r.TimeOffPeriod.IsHalfDay,
+		})
+	}
+	return days
+}
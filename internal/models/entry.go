@@ -21,10 +21,89 @@ type Entry struct {
 	TagIDs       []string     `json:"tagIds,omitempty"`
 }
 
+// IsRunning reports whether the entry is an in-progress timer.
+// Clockify returns a null end for entries that have not been stopped yet.
+func (e Entry) IsRunning() bool {
+	return !e.TimeInterval.Start.IsZero() && e.TimeInterval.End.IsZero()
+}
+
+// Duration returns the length of the entry.
+// Running entries are measured up to the current time.
+func (e Entry) Duration() time.Duration {
+	if e.TimeInterval.Start.IsZero() {
+		return 0
+	}
+	if e.IsRunning() {
+		return time.Since(e.TimeInterval.Start)
+	}
+	return e.TimeInterval.End.Sub(e.TimeInterval.Start)
+}
+
+// end returns when the entry stops, or now for running entries
+func (e Entry) end() time.Time {
+	if e.IsRunning() {
+		return time.Now()
+	}
+	return e.TimeInterval.End
+}
+
+// Days returns the start of each calendar day the entry touches, in loc
+// Entries that cross midnight touch more than one day.
+func (e Entry) Days(loc *time.Location) []time.Time {
+	if e.TimeInterval.Start.IsZero() {
+		return nil
+	}
+
+	start := e.TimeInterval.Start.In(loc)
+	end := e.end().In(loc)
+
+	days := []time.Time{startOfDay(start)}
+	for day := days[0].AddDate(0, 0, 1); day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// DurationOn returns the part of the entry that falls on day's calendar date
+// in day's location. Overnight entries are split at midnight.
+func (e Entry) DurationOn(day time.Time) time.Duration {
+	if e.TimeInterval.Start.IsZero() {
+		return 0
+	}
+
+	dayStart := startOfDay(day)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	start := e.TimeInterval.Start
+	if start.Before(dayStart) {
+		start = dayStart
+	}
+	end := e.end()
+	if end.After(dayEnd) {
+		end = dayEnd
+	}
+
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
 type TimeEntryRequest struct {
-	Start       string `json:"start"`
-	End         string `json:"end"`
-	ProjectID   string `json:"projectId"`
-	TaskID      string `json:"taskId,omitempty"`
-	Description string `json:"description"`
+	Start       string   `json:"start"`
+	End         string   `json:"end,omitempty"` // Leave empty to start a running timer
+	ProjectID   string   `json:"projectId"`
+	TaskID      string   `json:"taskId,omitempty"`
+	Description string   `json:"description"`
+	TagIDs      []string `json:"tagIds,omitempty"`
+	Billable    *bool    `json:"billable,omitempty"` // Leave nil to use the project's default
+}
+
+// StopTimerRequest is the payload used to stop the user's running timer
+type StopTimerRequest struct {
+	End string `json:"end"`
 }
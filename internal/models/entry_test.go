@@ -90,3 +90,89 @@ func TestIntervalTimeSerialization(t *testing.T) {
 		t.Errorf("End time mismatch: got %v, want %v", unmarshaled.End, interval.End)
 	}
 }
+
+func TestEntryIsRunning(t *testing.T) {
+	running := Entry{
+		TimeInterval: IntervalTime{
+			Start: time.Now().Add(-30 * time.Minute),
+		},
+	}
+	if !running.IsRunning() {
+		t.Error("Entry without an end time should be running")
+	}
+
+	stopped := Entry{
+		TimeInterval: IntervalTime{
+			Start: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		},
+	}
+	if stopped.IsRunning() {
+		t.Error("Entry with an end time should not be running")
+	}
+
+	if (Entry{}).IsRunning() {
+		t.Error("Empty entry should not be running")
+	}
+}
+
+func TestEntryDuration(t *testing.T) {
+	stopped := Entry{
+		TimeInterval: IntervalTime{
+			Start: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		},
+	}
+	if got := stopped.Duration(); got != 90*time.Minute {
+		t.Errorf("Duration mismatch: got %v, want %v", got, 90*time.Minute)
+	}
+
+	running := Entry{
+		TimeInterval: IntervalTime{
+			Start: time.Now().Add(-time.Hour),
+		},
+	}
+	if got := running.Duration(); got < time.Hour || got > time.Hour+time.Minute {
+		t.Errorf("Running duration should be about an hour, got %v", got)
+	}
+
+	// Clockify sends "end": null for running entries
+	var fromAPI Entry
+	data := []byte(`{"id":"1","timeInterval":{"start":"2024-01-15T09:00:00Z","end":null,"duration":null}}`)
+	if err := json.Unmarshal(data, &fromAPI); err != nil {
+		t.Fatalf("Failed to unmarshal running entry: %v", err)
+	}
+	if !fromAPI.IsRunning() {
+		t.Error("Entry with a null end should be running")
+	}
+}
+
+func TestOvernightEntrySplitsByDay(t *testing.T) {
+	loc := time.FixedZone("test", -3*60*60)
+	entry := Entry{
+		TimeInterval: IntervalTime{
+			Start: time.Date(2024, 1, 15, 22, 0, 0, 0, loc),
+			End:   time.Date(2024, 1, 16, 2, 30, 0, 0, loc),
+		},
+	}
+
+	days := entry.Days(loc)
+	if len(days) != 2 || days[0].Day() != 15 || days[1].Day() != 16 {
+		t.Fatalf("Expected the 15th and 16th, got %v", days)
+	}
+
+	if d := entry.DurationOn(days[0]); d != 2*time.Hour {
+		t.Errorf("Expected 2h before midnight, got %v", d)
+	}
+	if d := entry.DurationOn(days[1]); d != 150*time.Minute {
+		t.Errorf("Expected 2h30m after midnight, got %v", d)
+	}
+	if d := entry.DurationOn(time.Date(2024, 1, 17, 0, 0, 0, 0, loc)); d != 0 {
+		t.Errorf("Expected nothing on the 17th, got %v", d)
+	}
+
+	// The same entry seen from UTC falls on a single day
+	if days := entry.Days(time.UTC); len(days) != 1 {
+		t.Errorf("Expected one UTC day, got %v", days)
+	}
+}
@@ -0,0 +1,13 @@
+package models
+
+type Tag struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	WorkspaceID string `json:"workspaceId"`
+	Archived    bool   `json:"archived"`
+}
+
+// TagRequest is the payload used to create a tag
+type TagRequest struct {
+	Name string `json:"name"`
+}
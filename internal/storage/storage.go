@@ -1 +1,164 @@
+// Package storage keeps Clockify data on disk between launches, so the app
+// can show what it saw last time while it fetches the latest.
 package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// formatVersion is bumped when the layout of stored records changes
+// Records written by another version are treated as missing.
+const formatVersion = 1
+
+// ErrNotFound is returned by Load when nothing is stored under a key
+var ErrNotFound = errors.New("nothing stored")
+
+// Store persists values as JSON files, one directory per workspace
+type Store struct {
+	dir string
+}
+
+// record is what a stored file holds
+type record struct {
+	Version int             `json:"version"`
+	SavedAt time.Time       `json:"saved_at"` // For anyone inspecting the file
+	Data    json.RawMessage `json:"data"`
+}
+
+// New returns a store rooted at dir, which is created on the first save
+func New(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Default returns the store under ~/.cache/clockify-tui
+func Default() (*Store, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(filepath.Join(home, ".cache", "clockify-tui")), nil
+}
+
+// Dir returns the directory the store writes to
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Save writes v under key for the workspace
+// The file is replaced atomically, so a crash never leaves half a record behind.
+func (s *Store) Save(workspaceID, key string, v any) error {
+	path, err := s.path(workspaceID, key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	contents, err := json.Marshal(record{Version: formatVersion, SavedAt: time.Now(), Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Load reads the value stored under key for the workspace into v
+func (s *Store) Load(workspaceID, key string, v any) error {
+	path, err := s.path(workspaceID, key)
+	if err != nil {
+		return err
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var rec record
+	if err := json.Unmarshal(contents, &rec); err != nil {
+		return fmt.Errorf("failed to read stored %s: %w", key, err)
+	}
+	if rec.Version != formatVersion {
+		return ErrNotFound
+	}
+
+	if err := json.Unmarshal(rec.Data, v); err != nil {
+		return fmt.Errorf("failed to read stored %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes the value stored under key for the workspace, if there is one
+func (s *Store) Delete(workspaceID, key string) error {
+	path, err := s.path(workspaceID, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Clear removes everything stored for the workspace
+func (s *Store) Clear(workspaceID string) error {
+	dir, err := s.workspaceDir(workspaceID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dir)
+}
+
+func (s *Store) path(workspaceID, key string) (string, error) {
+	dir, err := s.workspaceDir(workspaceID)
+	if err != nil {
+		return "", err
+	}
+	if !validName(key) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(dir, key+".json"), nil
+}
+
+func (s *Store) workspaceDir(workspaceID string) (string, error) {
+	if !validName(workspaceID) {
+		return "", fmt.Errorf("invalid workspace ID %q", workspaceID)
+	}
+	return filepath.Join(s.dir, workspaceID), nil
+}
+
+// validName keeps IDs and keys from reaching outside the store's directory
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type item struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	store := New(t.TempDir())
+
+	saved := []item{{ID: "p1", Name: "Website"}, {ID: "p2", Name: "API"}}
+	if err := store.Save("ws1", "projects", saved); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var loaded []item
+	if err := store.Load("ws1", "projects", &loaded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(loaded) != 2 || loaded[1].Name != "API" {
+		t.Errorf("Expected the saved projects back, got %+v", loaded)
+	}
+
+	// Workspaces don't share data
+	if err := store.Load("ws2", "projects", &loaded); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected nothing stored for another workspace, got %v", err)
+	}
+
+	// Saving again replaces the record without leaving temporary files behind
+	if err := store.Save("ws1", "projects", saved[:1]); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	files, _ := os.ReadDir(filepath.Join(store.Dir(), "ws1"))
+	if len(files) != 1 || files[0].Name() != "projects.json" {
+		t.Errorf("Expected a single projects.json, got %v", files)
+	}
+}
+
+func TestLoadOtherVersion(t *testing.T) {
+	store := New(t.TempDir())
+	path := filepath.Join(store.Dir(), "ws1", "tags.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"version":0,"data":[{"id":"t1"}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var tags []item
+	if err := store.Load("ws1", "tags", &tags); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a record from another version to be ignored, got %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{not json`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Load("ws1", "tags", &tags); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a corrupt record to be reported, got %v", err)
+	}
+}
+
+func TestDeleteAndClear(t *testing.T) {
+	store := New(t.TempDir())
+	for _, key := range []string{"entries", "tags"} {
+		if err := store.Save("ws1", key, []item{{ID: "1"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := store.Delete("ws1", "tags"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.Delete("ws1", "tags"); err != nil {
+		t.Errorf("Deleting a missing key should succeed, got %v", err)
+	}
+	var entries []item
+	if err := store.Load("ws1", "entries", &entries); err != nil {
+		t.Errorf("Expected the entries to survive deleting tags, got %v", err)
+	}
+
+	if err := store.Clear("ws1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.Load("ws1", "entries", &entries); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected nothing left after clearing, got %v", err)
+	}
+}
+
+func TestInvalidNames(t *testing.T) {
+	store := New(t.TempDir())
+	for _, id := range []string{"", "..", "../escape", `ws\1`} {
+		if err := store.Save(id, "entries", nil); err == nil {
+			t.Errorf("Expected workspace ID %q to be rejected", id)
+		}
+	}
+	if err := store.Save("ws1", "../entries", nil); err == nil {
+		t.Error("Expected a key with a path to be rejected")
+	}
+}
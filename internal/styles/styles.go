@@ -59,6 +59,10 @@ var (
 	InfoStyle = lipgloss.NewStyle().
 			Foreground(Secondary)
 
+	WarningStyle = lipgloss.NewStyle().
+			Foreground(Warning).
+			Bold(true)
+
 	// Box styles
 	BoxStyle = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
@@ -145,10 +149,20 @@ var (
 				Padding(0, 2).
 				Foreground(Muted)
 
+	// Running timer indicator in the nav bar
+	TimerStyle = lipgloss.NewStyle().
+			Foreground(Error).
+			Padding(0, 2).
+			Bold(true)
+
 	// Tab separator style
 	SeparatorStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#333333"))
 
+	// Notifications, stacked above the information bar
+	ToastStyle = lipgloss.NewStyle().
+			Padding(0, 2)
+
 	// Information Bar
 	InfoBarStyle = lipgloss.NewStyle().
 			Background(Primary).
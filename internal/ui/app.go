@@ -6,17 +6,22 @@ import (
 	"clockify-app/internal/config"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
+	"clockify-app/internal/storage"
 	"clockify-app/internal/styles"
 	"clockify-app/internal/utils"
 	"strconv"
 	"strings"
+	"time"
 
 	"clockify-app/internal/ui/components/help"
 	"clockify-app/internal/ui/components/modal"
+	"clockify-app/internal/ui/components/notify"
+	"clockify-app/internal/ui/views/day"
 	"clockify-app/internal/ui/views/entries"
 	"clockify-app/internal/ui/views/month"
 	"clockify-app/internal/ui/views/project"
 	"clockify-app/internal/ui/views/projects"
+	"clockify-app/internal/ui/views/reports"
 	"clockify-app/internal/ui/views/settings"
 	"clockify-app/internal/ui/views/week"
 
@@ -34,6 +39,8 @@ const (
 	MonthView
 	ProjectsView
 	ProjectView
+	ReportsView
+	DayView
 )
 
 type Page struct {
@@ -41,12 +48,15 @@ type Page struct {
 	Key   View
 }
 
+// pages are the tabs in number key order, new views go at the end so existing keys stay put
 var pages = []Page{
 	{"Entries", EntriesView},
 	{"WeekView", WeekView},
 	{"MonthView", MonthView},
 	{"Projects", ProjectsView},
 	{"Settings", SettingsView},
+	{"Reports", ReportsView},
+	{"DayView", DayView},
 }
 
 type Model struct {
@@ -64,13 +74,22 @@ type Model struct {
 	entriesView  entries.Model  // List of Entries
 	projectsView projects.Model // List of Projects
 	projectView  project.Model  // Single Project view
+	dayView      day.Model      // Day timeline view
 	weekView     week.Model     // Week view
 	monthView    month.Model    // Month view
+	reportsView  reports.Model  // Reports view
+
+	// Running timer state
+	runningEntry *models.Entry
+	timerTicking bool
 
 	// Modal state
 	modal     *modal.Model
 	showModal bool
 
+	// Toasts above the info bar and the error log behind them
+	notify notify.Model
+
 	// UI Dimensions
 	width  int
 	height int
@@ -80,8 +99,13 @@ type Model struct {
 	viewport viewport.Model
 }
 
-func NewModel() Model {
-	cfg, _ := config.LoadConfig()
+// NewModel builds the app from the saved config, failing when the config can't be read
+func NewModel() (Model, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return Model{}, err
+	}
+	attachCache(cfg)
 
 	// Start at settings if no config
 	currentView := SettingsView
@@ -96,15 +120,79 @@ func NewModel() Model {
 		settingsView: settings.New(cfg),
 		entriesView:  entries.New(cfg),
 		projectsView: projects.New(cfg),
+		dayView:      day.New(cfg),
 		weekView:     week.New(cfg),
 		monthView:    month.New(cfg),
+		reportsView:  reports.New(cfg),
+		notify:       notify.New(),
 		ready:        false,
+	}, nil
+}
+
+// attachCache keeps the cache on disk for the workspace, so the next launch
+// starts from what this one saw. It's best effort, a cache that can't be
+// stored still works in memory.
+func attachCache(cfg *config.Config) {
+	if cfg == nil {
+		return
+	}
+	store, err := storage.Default()
+	if err != nil {
+		return
 	}
+	_ = cache.GetInstance().Attach(store, cfg.WorkspaceId)
 }
 
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.initializeFirstViewCmd(),
+		m.fetchRunningTimerCmd(),
+	)
+}
+
+// fetchRunningTimerCmd loads the running timer once the app is configured
+func (m Model) fetchRunningTimerCmd() tea.Cmd {
+	if m.config.APIKey == "" || m.config.WorkspaceId == "" || m.config.UserId == "" {
+		return nil
+	}
+	return api.FetchRunningTimer(
+		m.config.APIKey,
+		m.config.WorkspaceId,
+		m.config.UserId,
+	)
+}
+
+// timerTick schedules the next refresh of the running timer indicator
+func timerTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return messages.TimerTickMsg{Time: t}
+	})
+}
+
+// startTicking starts the indicator refresh loop unless one is already running
+func (m *Model) startTicking() tea.Cmd {
+	if m.runningEntry == nil || m.timerTicking {
+		return nil
+	}
+	m.timerTicking = true
+	return timerTick()
+}
+
+// withProjects loads the projects before running a view's init, which needs them
+// Stale projects are revalidated alongside, so the view isn't held up by the refetch.
+func (m Model) withProjects(init tea.Cmd) tea.Cmd {
+	return tea.Batch(
+		tea.Sequence(
+			api.FetchProjects(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+			),
+			init,
+		),
+		api.RevalidateProjects(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
 	)
 }
 
@@ -113,27 +201,21 @@ func (m Model) initializeFirstViewCmd() tea.Cmd {
 	case SettingsView:
 		return settings.Init()
 	case EntriesView:
-		return tea.Sequence(
-			api.FetchProjects(
-				m.config.APIKey,
-				m.config.WorkspaceId,
-			),
-			m.entriesView.Init(),
-		)
+		return m.withProjects(m.entriesView.Init())
 	case ProjectsView:
 		return m.projectsView.Init()
 
+	case DayView:
+		return m.withProjects(m.dayView.Init())
+
 	case WeekView:
-		return tea.Sequence(
-			api.FetchProjects(
-				m.config.APIKey,
-				m.config.WorkspaceId,
-			),
-			m.weekView.Init(),
-		)
+		return m.withProjects(m.weekView.Init())
 
 	case MonthView:
-		return m.monthView.Init()
+		return m.withProjects(m.monthView.Init())
+
+	case ReportsView:
+		return m.withProjects(m.reportsView.Init())
 	}
 	return nil
 }
@@ -172,10 +254,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.entriesView.SetSize(m.width, m.height)
 		case ProjectView:
 			m.projectView, cmd = m.projectView.Update(msg)
+		case DayView:
+			m.dayView.SetSize(m.width, m.height)
 		case WeekView:
 			m.weekView.SetSize(m.width, m.height)
 		case MonthView:
 			m.monthView.SetSize(m.width, m.height)
+		case ReportsView:
+			m.reportsView.SetSize(m.width, m.height)
 		case SettingsView:
 			m.settingsView.SetSize(m.width, m.height)
 		}
@@ -189,43 +275,59 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Let modal handle key events
 			break
 		}
+		if m.viewEditing() {
+			// Let the view's text input take every key
+			break
+		}
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
-		case "1", "2", "3", "4", "5":
+		case "1", "2", "3", "4", "5", "6", "7":
 			if num, err := strconv.Atoi(msg.String()); err == nil {
 				m.currentView = pages[num-1].Key
 				m.viewport.SetContent(m.renderContent())
 				// Initialize view if needed
 				switch m.currentView {
 				case EntriesView:
-					return m, tea.Sequence(
-						api.FetchProjects(
-							m.config.APIKey,
-							m.config.WorkspaceId,
-						),
-						m.entriesView.Init(),
-					)
+					return m, m.withProjects(m.entriesView.Init())
 				case ProjectsView:
 					m.projectsView.SetSize(m.width, m.height)
 					return m, m.projectsView.Init()
+				case DayView:
+					m.dayView.SetSize(m.width, m.height)
+					// Init keeps the day's fetch so it can be cancelled
+					init := m.dayView.Init()
+					return m, m.withProjects(init)
 				case WeekView:
 					m.weekView.SetSize(m.width, m.height)
-					return m, tea.Sequence(
-						api.FetchProjects(
-							m.config.APIKey,
-							m.config.WorkspaceId,
-						),
-						m.weekView.Init(),
-					)
+					return m, m.withProjects(m.weekView.Init())
 				case MonthView:
 					m.monthView.SetSize(m.width, m.height)
-					return m, m.monthView.Init()
+					// Init keeps the month's fetches so they can be cancelled
+					init := m.monthView.Init()
+					return m, m.withProjects(init)
+				case ReportsView:
+					m.reportsView.SetSize(m.width, m.height)
+					return m, m.withProjects(m.reportsView.Init())
 				case SettingsView:
 					return m, settings.Init()
 				}
 				return m, nil
 			}
+		case "S":
+			// Stop the running timer from any view that doesn't take text input
+			// Plain s is left to the views, the Entries view starts a timer with it
+			if m.runningEntry != nil && m.currentView != SettingsView {
+				return m, api.StopTimer(
+					m.config.APIKey,
+					m.config.WorkspaceId,
+					m.config.UserId,
+				)
+			}
+		case "!":
+			m.showModal = true
+			m.modal = modal.NewErrorLog(m.notify.Log())
+			return m, nil
 		case "n":
 			switch m.currentView {
 			case EntriesView:
@@ -254,12 +356,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					help.GenerateSection("Global Keys", help.Global),
 				)
 				return m, nil
+			case DayView:
+				m.modal = modal.NewHelp(
+					help.GenerateSection("Day View Keys", help.Day),
+					help.GenerateSection("Global Keys", help.Global),
+				)
+				return m, nil
 			case WeekView:
 				m.modal = modal.NewHelp(
 					help.GenerateSection("Week View Keys", help.Week),
 					help.GenerateSection("Global Keys", help.Global),
 				)
 				return m, nil
+			case MonthView:
+				m.modal = modal.NewHelp(
+					help.GenerateSection("Month View Keys", help.Month),
+					help.GenerateSection("Global Keys", help.Global),
+				)
+				return m, nil
+			case ReportsView:
+				m.modal = modal.NewHelp(
+					help.GenerateSection("Reports Keys", help.Reports),
+					help.GenerateSection("Global Keys", help.Global),
+				)
+				return m, nil
 			case SettingsView:
 				m.modal = modal.NewHelp(
 					help.GenerateSection("Settings Keys", help.Settings),
@@ -273,6 +393,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case messages.ErrorMsg:
+		m.notify, cmd = m.notify.Update(msg)
+		cmds = append(cmds, cmd)
+
+		// Forms and settings also show the failure inline
+		if m.showModal && m.modal != nil {
+			*m.modal, cmd = m.modal.Update(msg)
+			cmds = append(cmds, cmd)
+		} else if m.currentView == SettingsView {
+			m.settingsView, cmd = m.settingsView.Update(msg)
+			cmds = append(cmds, cmd)
+			m.viewport.SetContent(m.renderContent())
+		}
+		return m, tea.Batch(cmds...)
+
+	case messages.EntrySaveFailedMsg:
+		// Toasted like any error, and shown inline by the entry form that failed
+		m.notify, cmd = m.notify.Update(messages.ErrorMsg{Err: msg.Err})
+		cmds = append(cmds, cmd)
+		if m.showModal && m.modal != nil {
+			*m.modal, cmd = m.modal.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
+
+	case messages.NotifyMsg, notify.DismissMsg:
+		m.notify, cmd = m.notify.Update(msg)
+		return m, cmd
+
 	case messages.UserLoadedMsg:
 		m.userId = msg.UserId
 		m.settingsView, cmd = m.settingsView.Update(msg)
@@ -283,9 +432,63 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.config = msg.Config
 		m.userId = msg.UserId
 		m.workspaceId = msg.WorkspaceId
+		// Another workspace has its own projects, tags and entries
+		if cache.GetInstance().WorkspaceID() != msg.Config.WorkspaceId {
+			attachCache(msg.Config)
+		}
 		m.viewport.SetContent(m.renderContent())
-		_ = m.config.Save()
-		return m, nil
+		if err := m.config.Save(); err != nil {
+			return m, tea.Batch(
+				m.notify.Push(messages.NotifyError, "Could not save settings: "+err.Error()),
+				m.fetchRunningTimerCmd(),
+			)
+		}
+		return m, tea.Batch(
+			m.notify.Push(messages.NotifySuccess, "Settings saved"),
+			m.fetchRunningTimerCmd(),
+		)
+
+	case messages.RunningTimerLoadedMsg:
+		m.runningEntry = msg.Entry
+		return m, m.startTicking()
+
+	case messages.TimerStartedMsg:
+		entry := msg.Entry
+		m.runningEntry = &entry
+		cache := cache.GetInstance()
+		cache.AddEntry(msg.Entry)
+		m.monthView.InvalidateBalance()
+		return m, tea.Batch(
+			m.notify.Push(messages.NotifySuccess, "Timer started"),
+			m.startTicking(),
+			api.FetchEntries(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+				m.config.UserId,
+			),
+		)
+
+	case messages.TimerStoppedMsg:
+		m.runningEntry = nil
+		cache := cache.GetInstance()
+		cache.UpdateEntry(msg.Entry)
+		m.monthView.InvalidateBalance()
+		return m, tea.Batch(
+			m.notify.Push(messages.NotifySuccess, "Timer stopped"),
+			api.FetchEntries(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+				m.config.UserId,
+			),
+		)
+
+	case messages.TimerTickMsg:
+		// Let the loop die once the timer is stopped
+		if m.runningEntry == nil {
+			m.timerTicking = false
+			return m, nil
+		}
+		return m, timerTick()
 
 	case messages.ProjectsLoadedMsg:
 		m.projects = msg.Projects
@@ -295,8 +498,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.entriesView, cmd = m.entriesView.Update(msg)
 		case ProjectsView:
 			m.projectsView, cmd = m.projectsView.Update(msg)
+		case DayView:
+			m.dayView, cmd = m.dayView.Update(msg)
 		case WeekView:
 			m.weekView, cmd = m.weekView.Update(msg)
+		case MonthView:
+			m.monthView, cmd = m.monthView.Update(msg)
+		case ReportsView:
+			m.reportsView, cmd = m.reportsView.Update(msg)
 		}
 		m.viewport.SetContent(m.renderContent())
 		return m, cmd
@@ -310,6 +519,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.viewport.SetContent(m.renderContent())
 		return m, cmd
 
+	case messages.TagsLoadedMsg, messages.TagCreatedMsg:
+		// The entries list shows tag names and the entry form selects them
+		m.entriesView, cmd = m.entriesView.Update(msg)
+		cmds = append(cmds, cmd)
+		if m.showModal && m.modal != nil {
+			*m.modal, cmd = m.modal.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		m.viewport.SetContent(m.renderContent())
+		return m, tea.Batch(cmds...)
+
 	case messages.TasksLoadedMsg:
 		// Let project view handle the loaded tasks
 		if m.currentView == ProjectView {
@@ -331,10 +551,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cache := cache.GetInstance()
 		cache.AddEntry(msg.Entry)
 		m.entriesView, cmd = m.entriesView.Update(msg)
-		return m, api.FetchEntries(
-			m.config.APIKey,
-			m.config.WorkspaceId,
-			m.config.UserId,
+		refresh := m.refreshView()
+		return m, tea.Batch(
+			m.notify.Push(messages.NotifySuccess, "Entry saved"),
+			api.FetchEntries(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+				m.config.UserId,
+			),
+			refresh,
 		)
 
 	case messages.EntryUpdatedMsg:
@@ -342,16 +567,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cache := cache.GetInstance()
 		cache.UpdateEntry(msg.Entry)
 		m.entriesView, cmd = m.entriesView.Update(msg)
-		return m, api.FetchEntries(
-			m.config.APIKey,
-			m.config.WorkspaceId,
-			m.config.UserId,
+		refresh := m.refreshView()
+		return m, tea.Batch(
+			m.notify.Push(messages.NotifySuccess, "Entry updated"),
+			api.FetchEntries(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+				m.config.UserId,
+			),
+			refresh,
+		)
+
+	case messages.EntryTrimmedMsg:
+		// The entry form stays open, it only moved another entry out of the way
+		cache.GetInstance().UpdateEntry(msg.Entry)
+		refresh := m.refreshView()
+		cmds = append(cmds,
+			m.notify.Push(messages.NotifyInfo, "Trimmed overlapping entry"),
+			api.FetchEntries(
+				m.config.APIKey,
+				m.config.WorkspaceId,
+				m.config.UserId,
+			),
+			refresh,
 		)
+		if m.showModal && m.modal != nil {
+			*m.modal, cmd = m.modal.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+		return m, tea.Batch(cmds...)
 
 	case messages.EntriesLoadedMsg:
 		switch m.currentView {
 		case EntriesView:
 			m.entriesView, cmd = m.entriesView.Update(msg)
+		case DayView:
+			m.dayView, cmd = m.dayView.Update(msg)
+		case WeekView:
+			m.weekView, cmd = m.weekView.Update(msg)
+		case MonthView:
+			m.monthView, cmd = m.monthView.Update(msg)
+		case ReportsView:
+			m.reportsView, cmd = m.reportsView.Update(msg)
+		}
+		m.viewport.SetContent(m.renderContent())
+		return m, cmd
+
+	case messages.BalanceLoadedMsg:
+		// The balance doesn't depend on the displayed month, keep it whichever view is showing
+		m.monthView, cmd = m.monthView.Update(msg)
+		m.viewport.SetContent(m.renderContent())
+		return m, cmd
+
+	case messages.DaysOffLoadedMsg:
+		switch m.currentView {
 		case WeekView:
 			m.weekView, cmd = m.weekView.Update(msg)
 		case MonthView:
@@ -366,6 +635,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.viewport.SetContent(m.renderContent())
 		return m, nil
 
+	case messages.EntryCreateStartedMsg:
+		m.showModal = true
+		m.modal = modal.NewEntryFormAt(m.config, m.projects, msg.Start, msg.End, msg.ProjectID)
+		m.viewport.SetContent(m.renderContent())
+		return m, nil
+
 	case messages.EntryCopyStartedMsg:
 		m.showModal = true
 		m.modal = modal.CopyEntryForm(m.config, m.projects, msg.Entry)
@@ -391,7 +666,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cache := cache.GetInstance()
 			cache.DeleteEntry(msg.ID)
 			m.entriesView, cmd = m.entriesView.Update(msg)
-			return m, cmd
+			refresh := m.refreshView()
+			return m, tea.Batch(cmd, refresh)
 		}
 	}
 
@@ -412,12 +688,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.entriesView, cmd = m.entriesView.Update(msg)
 	case ProjectView:
 		m.projectView, cmd = m.projectView.Update(msg)
+	case DayView:
+		m.dayView, cmd = m.dayView.Update(msg)
+		handled = true
 	case WeekView:
 		m.weekView, cmd = m.weekView.Update(msg)
 		handled = true
 	case MonthView:
 		m.monthView, cmd = m.monthView.Update(msg)
 		handled = true
+	case ReportsView:
+		m.reportsView, cmd = m.reportsView.Update(msg)
+		handled = true
 	}
 	cmds = append(cmds, cmd)
 
@@ -439,6 +721,37 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// viewEditing reports whether the current view is taking text input, like the
+// entries filter or the report's custom range, so global keys are typed instead
+func (m Model) viewEditing() bool {
+	switch m.currentView {
+	case EntriesView:
+		return m.entriesView.Editing()
+	case ReportsView:
+		return m.reportsView.Editing()
+	}
+	return false
+}
+
+// refreshView fetches the day, week or month view's range again when it's showing,
+// since the app's own fetch only covers the entries list
+func (m *Model) refreshView() tea.Cmd {
+	// The balance counts every entry, not just the showing view's
+	if m.currentView != MonthView {
+		m.monthView.InvalidateBalance()
+	}
+
+	switch m.currentView {
+	case DayView:
+		return m.dayView.Refresh()
+	case WeekView:
+		return m.weekView.Refresh()
+	case MonthView:
+		return m.monthView.Refresh()
+	}
+	return nil
+}
+
 func (m Model) View() tea.View {
 
 	if !m.ready {
@@ -456,11 +769,18 @@ func (m Model) View() tea.View {
 	scrollbar := ""
 
 	switch m.currentView {
-	case EntriesView, ProjectsView, WeekView, MonthView:
+	case EntriesView, ProjectsView, DayView, WeekView, MonthView, ReportsView:
 		scrollbar = ""
 	}
+	// Toasts take their lines from the bottom of the viewport
+	toasts := m.notify.View(m.width)
+	vp := m.viewport
+	if toasts != "" {
+		vp.SetHeight(max(vp.Height()-lipgloss.Height(toasts), 0))
+	}
+
 	// The viewport already contains the view content in Update
-	viewportView := m.viewport.View()
+	viewportView := vp.View()
 
 	content := lipgloss.JoinHorizontal(
 		lipgloss.Top,
@@ -473,12 +793,18 @@ func (m Model) View() tea.View {
 		content = utils.RenderWithModal(m.height-5, m.width, content, m.modal.View().Content)
 	}
 
-	v := tea.NewView(lipgloss.JoinVertical(
-		lipgloss.Top,
-		navBar,
-		content,
-		styles.InfoBarStyle.Width(m.width).Render("[?]: help, [q][ctrl+c]: quit"),
-	))
+	infoBar := "[?]: help, [q][ctrl+c]: quit"
+	if len(m.notify.Log()) > 0 {
+		infoBar += ", [!]: error log"
+	}
+
+	sections := []string{navBar, content}
+	if toasts != "" {
+		sections = append(sections, toasts)
+	}
+	sections = append(sections, styles.InfoBarStyle.Width(m.width).Render(infoBar))
+
+	v := tea.NewView(lipgloss.JoinVertical(lipgloss.Top, sections...))
 	v.AltScreen = true
 
 	return v
@@ -516,9 +842,34 @@ func (m Model) RenderNavBar(activeTab string, docWidth int) string {
 		strings.Join(tabs, sep),
 	)
 
+	if timer := m.renderRunningTimer(); timer != "" {
+		fullNav = lipgloss.JoinHorizontal(lipgloss.Center, fullNav, sep, timer)
+	}
+
 	return styles.NavContainerStyle.Render(fullNav)
 }
 
+// renderRunningTimer renders the live indicator for the running timer
+// Returns an empty string when nothing is being tracked
+func (m Model) renderRunningTimer() string {
+	if m.runningEntry == nil {
+		return ""
+	}
+
+	description := m.runningEntry.Description
+	if description == "" {
+		description = "(No Description)"
+	}
+
+	parts := []string{description}
+	if project, err := utils.FindProjectById(m.projects, m.runningEntry.ProjectID); err == nil {
+		parts = append(parts, project.Name)
+	}
+	parts = append(parts, utils.FormatElapsed(m.runningEntry.Duration()))
+
+	return styles.TimerStyle.Render("● " + strings.Join(parts, " · "))
+}
+
 func (m Model) renderContent() string {
 	// Render active view
 	switch m.currentView {
@@ -530,10 +881,14 @@ func (m Model) renderContent() string {
 		return m.entriesView.View().Content
 	case ProjectView:
 		return m.projectView.View().Content
+	case DayView:
+		return m.dayView.View().Content
 	case WeekView:
 		return m.weekView.View().Content
 	case MonthView:
 		return m.monthView.View().Content
+	case ReportsView:
+		return m.reportsView.View().Content
 	}
 
 	return ""
@@ -28,18 +28,29 @@ type SimpleModel struct {
 	ready bool
 }
 
-func NewSimpleModel() SimpleModel {
-	cfg, _ := config.LoadConfig()
+// NewSimpleModel builds the quick entry form from the saved config, failing when the config can't be read
+func NewSimpleModel() (SimpleModel, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return SimpleModel{}, err
+	}
+	attachCache(cfg)
 	return SimpleModel{
 		config: cfg,
 		form:   entryform.New(cfg, []models.Project{}), // Empty projects for now
-	}
+	}, nil
 }
 
 func (m SimpleModel) Init() tea.Cmd {
-	return api.FetchProjects(
-		m.config.APIKey,
-		m.config.WorkspaceId,
+	return tea.Batch(
+		api.FetchProjects(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
+		api.RevalidateProjects(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
 	)
 }
 
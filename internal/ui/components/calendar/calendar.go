@@ -100,8 +100,11 @@ func New() Model {
 func (m *Model) SetInitalDay(day time.Time) {
 	m.initialDay = day
 }
+
+// SetSelectedDay selects day and shows its month
 func (m *Model) SetSelectedDay(day time.Time) {
 	m.SelectedDate = day
+	m.showSelectedMonth()
 }
 
 func (m Model) Init() tea.Cmd {
@@ -127,14 +130,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, m.KeyMap.PageDown):
 			m.SelectedDate = m.SelectedDate.AddDate(0, 1, 0)
 		}
-		// Set the current CurrentDate to the first day of the month if the selected date is in a different month
-		if m.SelectedDate.Month() != m.CurrentDate.Month() || m.SelectedDate.Year() != m.CurrentDate.Year() {
-			m.CurrentDate = time.Date(m.SelectedDate.Year(), m.SelectedDate.Month(), 1, 0, 0, 0, 0, m.SelectedDate.Location())
-		}
+		m.showSelectedMonth()
 	}
 	return m, nil
 }
 
+// showSelectedMonth moves CurrentDate to the first day of the selected month when they differ
+func (m *Model) showSelectedMonth() {
+	if m.SelectedDate.Month() != m.CurrentDate.Month() || m.SelectedDate.Year() != m.CurrentDate.Year() {
+		m.CurrentDate = time.Date(m.SelectedDate.Year(), m.SelectedDate.Month(), 1, 0, 0, 0, 0, m.SelectedDate.Location())
+	}
+}
+
 func (m Model) View() tea.View {
 	header := m.Styles.Header.Render(m.CurrentDate.Format("January 2006"))
 
@@ -34,6 +34,9 @@ func TestSetSelectedDay(t *testing.T) {
 	if !m.SelectedDate.Equal(testDate) {
 		t.Errorf("Expected SelectedDate %v, got %v", testDate, m.SelectedDate)
 	}
+	if m.CurrentDate.Month() != time.January || m.CurrentDate.Year() != 2026 {
+		t.Errorf("Expected the calendar to show January 2026, got %v", m.CurrentDate)
+	}
 }
 
 func TestUpdate_NextDay(t *testing.T) {
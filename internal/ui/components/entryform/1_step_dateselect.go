@@ -2,21 +2,28 @@ package entryform
 
 import (
 	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
 
+// calendarKeys move the calendar, every other key types into the date input
+var calendarKeys = []string{"up", "down", "left", "right", "pgup", "pgdown"}
+
 // ================ Date Selection =================
 func (m Model) viewDateSelect() string {
-	// Implementation of date selection view goes here
 	title := styles.TitleStyle.Margin(0, 0).Render("Select Date")
-	subtitle := styles.SubtitleStyle.Margin(0, 0, 1, 0).Render("Use arrow keys to navigate, Enter to select")
+	subtitle := styles.SubtitleStyle.Margin(0, 0, 1, 0).Render("Type a date or use the arrow keys, Enter to select")
 
-	// return m.calendar.View()
-	dateSelect := fmt.Sprintf("Selected Date\n%s", m.calendar.SelectedDate.Format("Mon, January 02, 2006"))
+	dateSelect := fmt.Sprintf("Selected Date\n%s\n\n📅 %s", m.calendar.SelectedDate.Format("Mon, January 02, 2006"), m.dateInput.View())
+	if m.dateErr != "" {
+		dateSelect += "\n" + styles.ErrorStyle.Render(m.dateErr)
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
@@ -28,20 +35,40 @@ func (m Model) viewDateSelect() string {
 			dateSelect,
 		),
 	)
-
 }
 
 func (m Model) updateDateSelect(msg tea.Msg) (Model, tea.Cmd) {
-	// Implementation of date selection update goes here
-	// Left arrow or 'h' (vim style) - previous day
-	switch msg := msg.(type) {
-	case tea.KeyPressMsg:
-		switch msg.String() {
-
-		case "t":
-			// 't' key to jump to today
-			m.calendar.SelectedDate = time.Now()
+	if msg, ok := msg.(tea.KeyPressMsg); ok && slices.Contains(calendarKeys, msg.String()) {
+		// Moving the calendar replaces whatever was typed
+		m.calendar, _ = m.calendar.Update(msg)
+		m.dateInput.SetValue("")
+		m.dateErr = ""
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.dateInput, cmd = m.dateInput.Update(msg)
+	if _, ok := msg.(tea.KeyPressMsg); ok {
+		m.dateErr = ""
+		// Follow the typed date as soon as it makes sense
+		if date, err := utils.ParseDate(m.dateInput.Value(), time.Now()); err == nil {
+			m.calendar.SetSelectedDay(date)
+		}
+	}
+	return m, cmd
+}
+
+// confirmDate checks the typed date before leaving the date step
+func (m *Model) confirmDate() bool {
+	if strings.TrimSpace(m.dateInput.Value()) != "" {
+		date, err := utils.ParseDate(m.dateInput.Value(), time.Now())
+		if err != nil {
+			m.dateErr = "Unknown date. Try today, yesterday, last fri, -2d, 2026-10-03 or 10/3."
+			return false
 		}
+		m.calendar.SetSelectedDay(date)
 	}
-	return m, nil
+	m.dateErr = ""
+	m.dateInput.Blur()
+	return true
 }
@@ -0,0 +1,191 @@
+package entryform
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/models"
+	"clockify-app/internal/styles"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// ================ Tag Selection =================
+func (m Model) viewTagSelect() string {
+	if !m.tagsReady {
+		return styles.SubtitleStyle.Render("Loading tags...")
+	}
+
+	sb := strings.Builder{}
+
+	// Title and subtitle
+	sb.WriteString(styles.TitleStyle.Margin(0, 0).Render("Select Tags") + "\n")
+	sb.WriteString(styles.SubtitleStyle.Margin(0, 0, 1, 0).Render("Space to toggle, Enter to continue") + "\n")
+
+	// Show search input
+	sb.WriteString("🔍 " + m.tagSearch.View() + "\n\n")
+
+	filteredTags := m.filterTags()
+
+	if len(filteredTags) == 0 {
+		query := strings.TrimSpace(m.tagSearch.Value())
+		if query != "" {
+			sb.WriteString(fmt.Sprintf("  No tags match. Press Enter to create %q.\n", query))
+		} else {
+			sb.WriteString("  No tags found in this workspace.\n")
+		}
+	}
+
+	// Calculate visible range for scrolling
+	const visibleItems = 5
+	start := 0
+	end := len(filteredTags)
+
+	// If we have more tags than can fit, show a window around cursor
+	if len(filteredTags) > visibleItems {
+		start = max(0, min(m.cursor-visibleItems/2, len(filteredTags)-visibleItems))
+		end = start + visibleItems
+
+		if start > 0 {
+			sb.WriteString(fmt.Sprintf("  ↑ %d more above...\n", start))
+		}
+	}
+
+	// Show visible tags with their selection state
+	for i := start; i < end; i++ {
+		tag := filteredTags[i]
+
+		check := "[ ]"
+		if m.tagSelected(tag.ID) {
+			check = "[x]"
+		}
+
+		if m.cursor == i {
+			sb.WriteString(styles.SelectedItemStyle.Render(fmt.Sprintf("❯ %s %s", check, tag.Name)) + "\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", check, tag.Name))
+		}
+	}
+
+	if end < len(filteredTags) {
+		sb.WriteString(fmt.Sprintf("  ↓ %d more below...\n", len(filteredTags)-end))
+	}
+
+	sb.WriteString("\n" + styles.HelpStyle.Render("Press / to search, type a new name and Enter to create it."))
+
+	return sb.String()
+}
+
+// updateTagSelect handles messages for the tag selection step.
+func (m Model) updateTagSelect(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	if msg, ok := msg.(tea.KeyPressMsg); ok {
+		if m.tagSearch.Focused() {
+			switch msg.String() {
+			case "up", "down":
+				// Arrows still move through the matches while searching
+			case "enter":
+				return m.submitTagSearch()
+			default:
+				m.tagSearch, cmd = m.tagSearch.Update(msg)
+				m.cursor = 0
+				return m, cmd
+			}
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+
+		case "down", "j":
+			if m.cursor < len(m.filterTags())-1 {
+				m.cursor++
+			}
+
+		case "space":
+			filtered := m.filterTags()
+			if m.cursor < len(filtered) {
+				m.toggleTag(filtered[m.cursor].ID)
+			}
+
+		case "/":
+			m.cursor = 0
+			return m, m.tagSearch.Focus()
+		}
+	}
+
+	return m, nil
+}
+
+// submitTagSearch creates the searched tag when nothing matches it,
+// otherwise it just leaves the search
+func (m Model) submitTagSearch() (Model, tea.Cmd) {
+	query := strings.TrimSpace(m.tagSearch.Value())
+	m.tagSearch.Blur()
+
+	if query == "" {
+		return m, nil
+	}
+
+	for _, tag := range m.tags {
+		if strings.EqualFold(tag.Name, query) {
+			// An exact match is selected rather than duplicated
+			if !m.tagSelected(tag.ID) {
+				m.toggleTag(tag.ID)
+			}
+			m.tagSearch.SetValue("")
+			m.cursor = 0
+			return m, nil
+		}
+	}
+
+	if len(m.filterTags()) > 0 {
+		return m, nil
+	}
+
+	return m, api.AddTag(m.apiKey, m.workspaceID, query)
+}
+
+// fetchTags loads the workspace tags the first time the tag step is shown
+func (m Model) fetchTags() tea.Cmd {
+	if m.tagsReady {
+		return nil
+	}
+	return tea.Batch(
+		api.FetchTags(m.apiKey, m.workspaceID),
+		api.RevalidateTags(m.apiKey, m.workspaceID),
+	)
+}
+
+// filterTags filters the list of tags based on the current search query.
+func (m Model) filterTags() []models.Tag {
+	query := strings.ToLower(strings.TrimSpace(m.tagSearch.Value()))
+	if query == "" {
+		return m.tags
+	}
+
+	var filtered []models.Tag
+	for _, tag := range m.tags {
+		if strings.Contains(strings.ToLower(tag.Name), query) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+func (m Model) tagSelected(id string) bool {
+	return slices.Contains(m.selectedTags, id)
+}
+
+// toggleTag selects the tag, or deselects it when already selected
+func (m *Model) toggleTag(id string) {
+	if i := slices.Index(m.selectedTags, id); i >= 0 {
+		m.selectedTags = slices.Delete(slices.Clone(m.selectedTags), i, i+1)
+		return
+	}
+	m.selectedTags = append(slices.Clone(m.selectedTags), id)
+}
@@ -0,0 +1,240 @@
+package entryform
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+var (
+	timeStartErr = ""
+	timeEndErr   = ""
+	timeNote     = "" // Result of the last fill gap
+)
+
+// ================ Time Selection =================
+func (m Model) viewTimeInput() string {
+	// Implementation of time input view goes here
+	title := styles.TitleStyle.Margin(0, 0).Render("Enter Time Range")
+	subtitle := styles.SubtitleStyle.Margin(0, 0, 1, 0).Render("Specify the start and end times for your work. The end can be a duration like +1h30.")
+
+	lines := []string{
+		title,
+		subtitle,
+		fmt.Sprintf("Start Time: %s", m.timeStart.View()),
+	}
+
+	if timeStartErr != "" {
+		lines = append(lines, styles.ErrorStyle.Render(timeStartErr))
+	}
+
+	endLine := fmt.Sprintf("End Time:   %s", m.timeEnd.View())
+	if m.endsNextDay() {
+		endLine += styles.HelpStyle.Render(" (next day)")
+	}
+	lines = append(lines, endLine)
+
+	if timeEndErr != "" {
+		lines = append(lines, styles.ErrorStyle.Render(timeEndErr))
+	}
+
+	if timeNote != "" {
+		lines = append(lines, styles.MutedTextStyle.Render(timeNote))
+	}
+
+	lines = append(lines, styles.HelpStyle.Render("Press Enter to continue, Ctrl+G to fill the nearest gap, or Tab/Shift+Tab to navigate."))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Top,
+		lines...,
+	)
+}
+
+func (m Model) updateTimeInput(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyPressMsg); ok && msg.String() == "ctrl+g" {
+		m.fillGap()
+		return m, nil
+	}
+
+	// Update the text inputs
+	var cmd tea.Cmd
+	m.timeStart, cmd = m.timeStart.Update(msg)
+	m.timeEnd, _ = m.timeEnd.Update(msg) // Ignoring second cmd for simplicity
+
+	return m, cmd
+}
+
+func (m *Model) validate() {
+
+	startStr := m.timeStart.Value()
+	endStr := m.timeEnd.Value()
+
+	if startStr == "" {
+		timeStartErr = "Start time cannot be empty."
+	}
+
+	// A running entry being edited can be left running
+	if m.editingRunning() {
+		if _, err := utils.ParseTime(startStr, m.calendar.SelectedDate); err != nil {
+			timeStartErr = "Invalid start time format. Use HH:MM or now."
+		}
+		return
+	}
+
+	if endStr == "" {
+		timeEndErr = "End time cannot be empty."
+	}
+
+	startTime, err1 := utils.ParseTime(startStr, m.calendar.SelectedDate)
+
+	// A duration end needs a start to count from, a clock time only needs the date
+	anchor := startTime
+	if err1 != nil {
+		anchor = m.calendar.SelectedDate
+	}
+	endTime, err2 := utils.ParseEndTime(endStr, anchor)
+
+	if err1 != nil {
+		timeStartErr = "Invalid start time format. Use HH:MM or now."
+	}
+
+	if err2 != nil {
+		timeEndErr = "Invalid end time format. Use HH:MM or a duration like +1h30."
+	}
+
+	if err1 != nil || err2 != nil {
+		return
+	}
+
+	if _, err := api.NewTimeRange(startTime, rollEnd(startTime, endTime)); err != nil {
+		timeEndErr = rangeErrorText(err)
+	}
+}
+
+// editingRunning reports whether a running entry is being edited with its end left empty
+func (m Model) editingRunning() bool {
+	return m.editing && m.selectedEntry.IsRunning() && m.timeEnd.Value() == ""
+}
+
+// startTime parses the start input on the selected date
+func (m Model) startTime() (time.Time, error) {
+	start, err := utils.ParseTime(m.timeStart.Value(), m.calendar.SelectedDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time: %w", err)
+	}
+	return start, nil
+}
+
+// timeRange parses the start and end inputs on the selected date
+func (m Model) timeRange() (api.TimeRange, error) {
+	start, err := m.startTime()
+	if err != nil {
+		return api.TimeRange{}, err
+	}
+	end, err := utils.ParseEndTime(m.timeEnd.Value(), start)
+	if err != nil {
+		return api.TimeRange{}, fmt.Errorf("invalid end time: %w", err)
+	}
+	return api.NewTimeRange(start, rollEnd(start, end))
+}
+
+// rollEnd moves an end that is earlier than the start to the next day,
+// so a 10p to 2a shift ends the morning after it started
+func rollEnd(start, end time.Time) time.Time {
+	if end.Before(start) {
+		return end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// endsNextDay reports whether the entered range crosses midnight
+func (m Model) endsNextDay() bool {
+	r, err := m.timeRange()
+	return err == nil && !sameDay(r.Start, r.End)
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// rangeErrorText explains why a time range was rejected
+func rangeErrorText(err error) string {
+	switch {
+	case errors.Is(err, api.ErrEndBeforeStart):
+		return "End time must be after start time."
+	case errors.Is(err, api.ErrZeroLength):
+		return "Entry must be at least one minute long."
+	case errors.Is(err, api.ErrTooLong):
+		return "Entries can't be longer than 24 hours."
+	default:
+		return err.Error()
+	}
+}
+
+// fetchDayEntries loads the selected day's entries for the overlap and gap checks
+func (m Model) fetchDayEntries() tea.Cmd {
+	if sameDay(m.dayLoaded, m.calendar.SelectedDate) {
+		return nil
+	}
+	return api.FetchDayEntries(m.apiKey, m.workspaceID, m.userID, m.calendar.SelectedDate)
+}
+
+// fillGap sets the time inputs to the free time nearest the typed start,
+// or nearest now when there's no start yet
+func (m *Model) fillGap() {
+	timeStartErr, timeEndErr = "", ""
+
+	if !sameDay(m.dayLoaded, m.calendar.SelectedDate) {
+		timeNote = "Still loading the day's entries..."
+		return
+	}
+
+	date := m.calendar.SelectedDate
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	// The time after the last entry is free up to now, or until the end of a past day
+	until := time.Now()
+	if until.After(dayEnd) {
+		until = dayEnd
+	}
+
+	var gaps []utils.Span
+	for _, gap := range utils.Gaps(m.dayEntries, m.selectedEntry.ID, until) {
+		// Only whole minutes on the selected day, the inputs can't hold seconds
+		if gap.Start.Before(dayStart) {
+			gap.Start = dayStart
+		}
+		if gap.End.After(dayEnd) {
+			gap.End = dayEnd
+		}
+		if rounded := gap.Start.Truncate(time.Minute); rounded.Before(gap.Start) {
+			gap.Start = rounded.Add(time.Minute)
+		}
+		gap.End = gap.End.Truncate(time.Minute)
+		if gap.End.After(gap.Start) {
+			gaps = append(gaps, gap)
+		}
+	}
+
+	at := until
+	if start, err := utils.ParseTime(m.timeStart.Value(), date); err == nil {
+		at = start
+	}
+
+	gap, ok := utils.NearestGap(gaps, at)
+	if !ok {
+		timeNote = "No gaps to fill on this day."
+		return
+	}
+
+	m.timeStart.SetValue(gap.Start.In(time.Local).Format("3:04 PM"))
+	m.timeEnd.SetValue(gap.End.In(time.Local).Format("3:04 PM"))
+	timeNote = fmt.Sprintf("Filled the gap from %s to %s.", m.timeStart.Value(), m.timeEnd.Value())
+}
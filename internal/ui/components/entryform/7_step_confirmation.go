@@ -0,0 +1,317 @@
+package entryform
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// ================ Confirmation Selection =================
+func (m Model) viewConfirm() string {
+	// Implementation of confirmation view goes here
+	chosenDate := m.calendar.SelectedDate.Format("January 2, 2006")
+	chosenStart := m.timeStart.Value()
+	chosenEnd := m.timeEnd.Value()
+	if m.editingRunning() {
+		chosenEnd = "running"
+	}
+	if m.endsNextDay() {
+		chosenEnd += " (next day)"
+	}
+	chosenDescription := m.description.Value()
+	chosenTask := m.selectedTask.Name
+	chosenTags := strings.Join(utils.TagNames(m.tags, m.selectedTags), ", ")
+	if chosenTags == "" {
+		chosenTags = "None"
+	}
+	chosenBillable := "No"
+	if m.billable {
+		chosenBillable = "Yes"
+	}
+	choseProject := m.selectedProj
+
+	confirmationBtn := styles.ActiveButtonStyle
+	confirmationBtnText := "Create"
+	confirmationText := "Press Enter to confirm, or Tab/Shift+Tab to navigate."
+
+	if m.editing {
+		confirmationBtnText = "Update"
+		confirmationText = "Press Enter to update, or Tab/Shift+Tab to navigate."
+	}
+
+	lines := []string{
+		styles.TitleStyle.Margin(0, 0).Render("Confirm Time Entry"),
+		styles.SubtitleStyle.Margin(0, 0, 1, 0).Render("Please review your time entry details:"),
+		fmt.Sprintf("📅 Date: %s", chosenDate),
+		fmt.Sprintf("⏰ Time: %s - %s", chosenStart, chosenEnd),
+		fmt.Sprintf("📝 Description: %s", chosenDescription),
+		fmt.Sprintf("📁 Project: %s (%s)", choseProject.Name, choseProject.ClientName),
+		fmt.Sprintf("🗂️ Task: %s", chosenTask),
+		fmt.Sprintf("🏷️ Tags: %s", chosenTags),
+		fmt.Sprintf("💲 Billable: %s %s\n", chosenBillable, styles.HelpStyle.Render("[b] toggle")),
+	}
+
+	// Warn about entries this one would overlap
+	if overlapping := m.overlaps(); len(overlapping) > 0 {
+		for _, entry := range overlapping {
+			lines = append(lines, styles.WarningStyle.Render(fmt.Sprintf("⚠ Overlaps %s", describeEntry(entry))))
+		}
+		if len(m.trims()) > 0 {
+			lines = append(lines, styles.HelpStyle.Render("[x] trim the overlapping entries"))
+		}
+		lines = append(lines, "")
+	}
+
+	// Show why the last save failed
+	if m.err != nil {
+		lines = append(lines, styles.ErrorStyle.Render(submitErrorText(m.err))+"\n")
+	}
+
+	lines = append(lines,
+		confirmationBtn.Render(confirmationBtnText),
+		styles.HelpStyle.Render(confirmationText),
+	)
+
+	return lipgloss.JoinVertical(lipgloss.Top, lines...)
+}
+
+// submitErrorText describes a failed save, using Clockify's message for validation errors
+func submitErrorText(err error) string {
+	switch {
+	case errors.Is(err, api.ErrValidation):
+		return "✗ " + api.ErrorMessage(err)
+	case errors.Is(err, api.ErrNotFound):
+		return "✗ This entry no longer exists in Clockify."
+	case errors.Is(err, api.ErrUnauthorized):
+		return "✗ Your API key was rejected. Update it in Settings."
+	default:
+		return "✗ Could not save entry: " + api.ErrorMessage(err)
+	}
+}
+
+func (m Model) updateConfirm(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyPressMsg); ok {
+		switch msg.String() {
+		case "b":
+			m.billable = !m.billable
+		case "x":
+			return m, m.trimOverlaps()
+		}
+	}
+	return m, nil
+}
+
+// overlaps returns the loaded entries that share time with the entered range
+func (m Model) overlaps() []models.Entry {
+	interval, err := m.timeRange()
+	if err != nil {
+		return nil
+	}
+	return utils.Overlaps(m.dayEntries, utils.Span{Start: interval.Start, End: interval.End}, m.selectedEntry.ID)
+}
+
+// trims returns the changes that move overlapping entries out of the entered range,
+// keyed by entry ID. Entries that would be trimmed away entirely, or that enclose the
+// range and would lose their tail, are left alone.
+func (m Model) trims() map[string]api.EntryChanges {
+	interval, err := m.timeRange()
+	if err != nil {
+		return nil
+	}
+
+	span := utils.Span{Start: interval.Start, End: interval.End}
+	trims := make(map[string]api.EntryChanges)
+	for _, entry := range m.overlaps() {
+		trimmed, ok := utils.Trim(entry, span)
+		if !ok {
+			continue
+		}
+		var changes api.EntryChanges
+		if !trimmed.Start.Equal(entry.TimeInterval.Start) {
+			changes.Start = &trimmed.Start
+		}
+		if !trimmed.End.Equal(entry.TimeInterval.End) {
+			changes.End = &trimmed.End
+		}
+		trims[entry.ID] = changes
+	}
+	return trims
+}
+
+// trimOverlaps shortens every overlapping entry that can be trimmed
+func (m Model) trimOverlaps() tea.Cmd {
+	var cmds []tea.Cmd
+	for entryID, changes := range m.trims() {
+		cmds = append(cmds, trimTimeEntry(m.apiKey, m.workspaceID, entryID, changes))
+	}
+	return tea.Batch(cmds...)
+}
+
+// describeEntry names an entry and its times for the overlap warning
+func describeEntry(entry models.Entry) string {
+	description := entry.Description
+	if description == "" {
+		description = "(No Description)"
+	}
+	end := "running"
+	if !entry.IsRunning() {
+		end = entry.TimeInterval.End.In(time.Local).Format("3:04 PM")
+	}
+	return fmt.Sprintf("%q %s - %s", description, entry.TimeInterval.Start.In(time.Local).Format("3:04 PM"), end)
+}
+
+// submitTimeEntry creates a command to submit the time entry
+func (m Model) submitTimeEntry() tea.Cmd {
+	interval, err := m.timeRange()
+	if err != nil {
+		return reportError(err)
+	}
+
+	return createTimeEntry(m.apiKey, m.workspaceID, api.NewEntry{
+		ProjectID:   m.selectedProj.ID,
+		TaskID:      m.selectedTask.ID,
+		Description: m.description.Value(),
+		TagIDs:      m.selectedTags,
+		Billable:    m.billable,
+		Range:       interval,
+	})
+}
+
+func (m Model) updateTimeEntry() tea.Cmd {
+	changes, err := m.entryChanges()
+	if err != nil {
+		return reportError(err)
+	}
+
+	return updateTimeEntry(
+		m.apiKey,
+		m.workspaceID,
+		m.selectedEntry.ID,
+		changes,
+	)
+}
+
+// reportError returns a command that fails the submit without a request
+func reportError(err error) tea.Cmd {
+	return func() tea.Msg {
+		return messages.EntrySaveFailedMsg{Err: err}
+	}
+}
+
+// entryChanges lists what the user changed on the entry being edited,
+// so the update leaves everything else as Clockify has it
+func (m Model) entryChanges() (api.EntryChanges, error) {
+	entry := m.selectedEntry
+	var changes api.EntryChanges
+
+	if description := m.description.Value(); description != entry.Description {
+		changes.Description = &description
+	}
+	if projectID := m.selectedProj.ID; projectID != entry.ProjectID {
+		changes.ProjectID = &projectID
+	}
+	if taskID := m.selectedTask.ID; taskID != entry.TaskID {
+		changes.TaskID = &taskID
+	}
+	if !sameTags(m.selectedTags, entry.TagIDs) {
+		changes.TagIDs = append([]string{}, m.selectedTags...)
+	}
+	if billable := m.billable; billable != entry.Billable {
+		changes.Billable = &billable
+	}
+
+	// A running entry left without an end keeps running, only its start can move
+	if m.editingRunning() {
+		start, err := m.startTime()
+		if err != nil {
+			return api.EntryChanges{}, err
+		}
+		if !start.Equal(entry.TimeInterval.Start.Truncate(time.Minute)) {
+			changes.Start = &start
+		}
+		return changes, nil
+	}
+
+	// The inputs only hold whole minutes
+	interval, err := m.timeRange()
+	if err != nil {
+		return api.EntryChanges{}, err
+	}
+	if start := interval.Start; !start.Equal(entry.TimeInterval.Start.Truncate(time.Minute)) {
+		changes.Start = &start
+	}
+	if end := interval.End; !end.Equal(entry.TimeInterval.End.Truncate(time.Minute)) {
+		changes.End = &end
+	}
+
+	return changes, nil
+}
+
+// sameTags reports whether two lists hold the same tags, in any order
+func sameTags(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// createTimeEntry returns a command that creates a time entry
+// When complete, it sends either EntrySavedMsg or EntrySaveFailedMsg
+func createTimeEntry(apiKey, workspaceID string, newEntry api.NewEntry) tea.Cmd {
+	return func() tea.Msg {
+		client := api.NewClient(apiKey)
+		entry, err := client.CreateTimeEntry(context.Background(), workspaceID, newEntry)
+
+		if err != nil {
+			return messages.EntrySaveFailedMsg{Err: err}
+		}
+
+		// Success - return success message
+		return messages.EntrySavedMsg{
+			Entry: entry,
+		}
+	}
+}
+
+func updateTimeEntry(apiKey, workspaceID, entryID string, changes api.EntryChanges) tea.Cmd {
+	return func() tea.Msg {
+		client := api.NewClient(apiKey)
+		entry, err := client.UpdateTimeEntry(context.Background(), workspaceID, entryID, changes)
+
+		if err != nil {
+			return messages.EntrySaveFailedMsg{Err: err}
+		}
+
+		// Success - return success message
+		return messages.EntryUpdatedMsg{
+			Entry: entry,
+		}
+	}
+}
+
+// trimTimeEntry returns a command that shortens an overlapping entry
+func trimTimeEntry(apiKey, workspaceID, entryID string, changes api.EntryChanges) tea.Cmd {
+	return func() tea.Msg {
+		client := api.NewClient(apiKey)
+		entry, err := client.UpdateTimeEntry(context.Background(), workspaceID, entryID, changes)
+
+		if err != nil {
+			return messages.ErrorMsg{Err: err}
+		}
+
+		return messages.EntryTrimmedMsg{
+			Entry: entry,
+		}
+	}
+}
@@ -8,11 +8,11 @@ import (
 	"clockify-app/internal/models"
 	"clockify-app/internal/styles"
 	"clockify-app/internal/ui/components/calendar"
+	"slices"
 	"time"
 
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
-	"charm.land/lipgloss/v2"
 )
 
 // These constants represent each screen in our UI flow
@@ -22,6 +22,7 @@ const (
 	stepDescriptionInput        //  Enter task description
 	stepProjectSelect           //  Select which project
 	stepTaskInput               //  Select a task if applicable
+	stepTagSelect               //  Select any number of tags
 	stepTimeInput               //  Enter time range (e.g., "9a - 5p")
 	stepConfirm                 //  Review and confirm the entry
 	stepComplete                //  Show success message
@@ -31,6 +32,7 @@ type Model struct {
 	// Current step in the workflow (which screen we're on)
 	apiKey      string
 	workspaceID string
+	userID      string
 	step        int
 	StepLines   int // Number of lines in the current step's view (for viewport sizing)
 
@@ -38,6 +40,10 @@ type Model struct {
 	projects   []models.Project // List of available projects
 	tasks      []models.Task    //
 	tasksReady bool             // Whether tasks have been loaded
+	tags       []models.Tag     // Workspace tags
+	tagsReady  bool             // Whether tags have been loaded
+	dayEntries []models.Entry   // Entries around the selected date, to check for overlaps and gaps
+	dayLoaded  time.Time        // Date dayEntries were loaded for, zero until they arrive
 
 	// Navigation state
 	cursor   int // Current position in lists (for arrow key navigation)
@@ -45,6 +51,8 @@ type Model struct {
 
 	// User inputs
 	calendar       calendar.Model  // Calendar component for date selection
+	dateInput      textinput.Model // Text input for typed dates (e.g., "yesterday" or "-2d")
+	dateErr        string          // Why the typed date couldn't be used
 	timeStart      textinput.Model // Text input for start time (e.g., "9:00 AM")
 	timeEnd        textinput.Model // Text input for end time (e.g., "5:00 PM")
 	description    textinput.Model // Text input for task description
@@ -53,6 +61,9 @@ type Model struct {
 	selectedProj   models.Project  // The project user selected
 	selectedProjID int             // ID of the selected project
 	selectedTask   models.Task     // The task user selected
+	selectedTags   []string        // IDs of the tags user selected, in selection order
+	billable       bool            // Whether the entry is billable, defaults from the project
+	tagSearch      textinput.Model // Text input for tag search, or the name of a new tag
 	selectedEntry  models.Entry    // The time entry being edited (if any)
 
 	// Status flags
@@ -68,16 +79,23 @@ func New(cfg *config.Config, projects []models.Project) Model {
 	calendarModel.Styles.InitialDay = calendarModel.Styles.Selected.Background(styles.Primary).Foreground(styles.Muted).Bold(true)
 	calendarModel.Styles.Selected = calendarModel.Styles.Selected.Background(styles.Secondary).Foreground(styles.Background).Bold(true)
 
+	// Create and configure the typed date input, focused since the form starts on the date step
+	dateInput := textinput.New()
+	dateInput.Placeholder = "e.g., yesterday, last fri, -2d, 10/3"
+	dateInput.CharLimit = 20
+	dateInput.SetWidth(30)
+	dateInput.Focus()
+
 	// Create and configure the start time input
 	timeStartInput := textinput.New()
-	timeStartInput.Placeholder = "e.g., 9a"
-	timeStartInput.CharLimit = 8 // "12:00 PM" is 8 characters
+	timeStartInput.Placeholder = "e.g., 9a or now-15m"
+	timeStartInput.CharLimit = 12 // Room for "now - 1h30m"
 	timeStartInput.SetWidth(30)
 
 	// Create and configure the time end input
 	timeEndInput := textinput.New()
-	timeEndInput.Placeholder = "e.g., 9a"
-	timeEndInput.CharLimit = 8 // "12:00 PM" is 8 characters
+	timeEndInput.Placeholder = "e.g., 5p or +1h30"
+	timeEndInput.CharLimit = 12 // Room for "now - 1h30m"
 	timeEndInput.SetWidth(30)
 
 	// Create and configure the task name input
@@ -97,16 +115,24 @@ func New(cfg *config.Config, projects []models.Project) Model {
 	searchInput.Placeholder = "Search projects..."
 	searchInput.SetWidth(50)
 
+	// Create and configure the tag search input
+	tagSearchInput := textinput.New()
+	tagSearchInput.Placeholder = "Search or create tags..."
+	tagSearchInput.SetWidth(50)
+
 	return Model{
 		apiKey:        cfg.APIKey,
 		workspaceID:   cfg.WorkspaceId,
+		userID:        cfg.UserId,
 		step:          stepDateSelect, // Start at date selection
 		calendar:      calendarModel,
+		dateInput:     dateInput,
 		timeStart:     timeStartInput,
 		timeEnd:       timeEndInput,
 		description:   descriptionInput,
 		task:          taskInput,
 		projectSearch: searchInput,
+		tagSearch:     tagSearchInput,
 		projects:      projects,
 		cursor:        0, // Start at first item in lists
 		editing:       false,
@@ -120,13 +146,19 @@ func (m Model) CopyEntry(entry models.Entry) Model {
 	m.selectedEntry = entry
 
 	m.description.SetValue(entry.Description)
+	m.selectedTags = append([]string(nil), entry.TagIDs...)
+	m.billable = entry.Billable
+	m.selectedTask = models.Task{ID: entry.TaskID}
 
 	// Setup Calendar
 	m.calendar.SetSelectedDay(entry.TimeInterval.Start.In(time.Local))
 
-	// Pre-fill time inputs
+	// Pre-fill time inputs (running entries have no end yet)
 	startStr := entry.TimeInterval.Start.In(time.Local).Format("3:04 PM")
-	endStr := entry.TimeInterval.End.In(time.Local).Format("3:04 PM")
+	endStr := ""
+	if !entry.IsRunning() {
+		endStr = entry.TimeInterval.End.In(time.Local).Format("3:04 PM")
+	}
 	m.timeStart.SetValue(startStr)
 	m.timeEnd.SetValue(endStr)
 
@@ -156,13 +188,19 @@ func (m Model) UpdateEntry(entry models.Entry) Model {
 	m.selectedEntry = entry
 
 	m.description.SetValue(entry.Description)
+	m.selectedTags = append([]string(nil), entry.TagIDs...)
+	m.billable = entry.Billable
+	m.selectedTask = models.Task{ID: entry.TaskID}
 
 	// Setup Calendar
 	m.calendar.SetSelectedDay(entry.TimeInterval.Start.In(time.Local))
 
-	// Pre-fill time inputs
+	// Pre-fill time inputs (running entries have no end yet)
 	startStr := entry.TimeInterval.Start.In(time.Local).Format("3:04 PM")
-	endStr := entry.TimeInterval.End.In(time.Local).Format("3:04 PM")
+	endStr := ""
+	if !entry.IsRunning() {
+		endStr = entry.TimeInterval.End.In(time.Local).Format("3:04 PM")
+	}
 	m.timeStart.SetValue(startStr)
 	m.timeEnd.SetValue(endStr)
 
@@ -186,6 +224,28 @@ func (m Model) UpdateEntry(entry models.Entry) Model {
 	return m
 }
 
+// StartAt fills in the date and times for a new entry covering [start, end)
+func (m Model) StartAt(start, end time.Time) Model {
+	m.calendar.SetSelectedDay(start.In(time.Local))
+	m.timeStart.SetValue(start.In(time.Local).Format("3:04 PM"))
+	m.timeEnd.SetValue(end.In(time.Local).Format("3:04 PM"))
+	return m
+}
+
+// ForProject preselects the project for a new entry, used when adding time from the week grid
+func (m Model) ForProject(projectID string) Model {
+	for i, proj := range m.projects {
+		if proj.ID == projectID {
+			m.selectedProj = proj
+			m.selectedProjID = i
+			m.cursor = i
+			m.billable = proj.IsBillable
+			break
+		}
+	}
+	return m
+}
+
 func (m Model) Init() tea.Cmd {
 	return textinput.Blink
 }
@@ -208,19 +268,30 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case "esc":
 			// Handle escape to exit the form
 			// Reset form state if needed
-			m = New(&config.Config{APIKey: m.apiKey, WorkspaceId: m.workspaceID}, m.projects)
+			m = New(&config.Config{APIKey: m.apiKey, WorkspaceId: m.workspaceID, UserId: m.userID}, m.projects)
 			timeStartErr = "" // Located in time input step file
 			timeEndErr = ""
+			timeNote = ""
 		case "tab":
 			// Handle tab to go to next step
-			if m.projectSearch.Focused() {
-				// If project search is focused, don't move to next step
+			if m.projectSearch.Focused() || m.tagSearch.Focused() {
+				// If a search is focused, don't move to next step
 				break
 			}
 
 			switch m.step {
+			case stepDateSelect:
+				if !m.confirmDate() {
+					return m, nil
+				}
+				m.description.Focus()
 			case stepTaskInput:
+				m.step = stepTagSelect
+				m.cursor = 0
+				return m, m.fetchTags()
+			case stepTagSelect:
 				m.timeStart.Focus()
+				cmds = append(cmds, m.fetchDayEntries())
 			case stepTimeInput:
 				// If we're in time input step, ensure end time is focused next
 				if m.timeStart.Focused() {
@@ -247,16 +318,20 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		case "shift+tab":
 			// Handle shift+tab to go back a step
-			if m.projectSearch.Focused() {
-				// If project search is focused, don't move to next step
+			if m.projectSearch.Focused() || m.tagSearch.Focused() {
+				// If a search is focused, don't move to next step
 				break
 			}
 
 			switch m.step {
+			case stepDescriptionInput:
+				m.description.Blur()
+				m.dateInput.Focus()
 			case stepProjectSelect:
 				// Reset time input errors when going back
 				timeStartErr = "" // Located in time input step file
 				timeEndErr = ""
+				timeNote = ""
 				m.description.Focus()
 			case stepTimeInput:
 				// Blur both inputs
@@ -271,6 +346,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			switch m.step {
 
 			case stepDateSelect:
+				if !m.confirmDate() {
+					return m, nil
+				}
 				m.step = stepDescriptionInput
 				m.description.Focus()
 
@@ -291,6 +369,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				}
 				filtered := m.filterProjects()
 				if len(filtered) > 0 && m.cursor < len(filtered) {
+					// Switching project resets billable to the new project's default
+					if filtered[m.cursor].ID != m.selectedProj.ID {
+						m.billable = filtered[m.cursor].IsBillable
+					}
 					m.selectedProj = filtered[m.cursor]
 					m.task.Focus()
 					m.cursor = 0
@@ -299,21 +381,35 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 					m.step = stepTaskInput
 					m.cursor = 0
 				}
-				return m, api.FetchTasks(m.apiKey, m.workspaceID, m.selectedProj.ID)
+				return m, tea.Batch(
+					api.FetchTasks(m.apiKey, m.workspaceID, m.selectedProj.ID),
+					api.RevalidateTasks(m.apiKey, m.workspaceID, m.selectedProj.ID),
+				)
 
 			case stepTaskInput:
 				if len(m.tasks) > 0 {
 					m.selectedTask = m.tasks[m.cursor]
 				}
 				m.task.Blur()
+				m.step = stepTagSelect
+				m.cursor = 0
+				return m, m.fetchTags()
+
+			case stepTagSelect:
+				if m.tagSearch.Focused() {
+					// Let the tag step create or pick the searched tag
+					break
+				}
 				m.step = stepTimeInput
 				m.timeStart.Focus()
+				cmds = append(cmds, m.fetchDayEntries())
 
 			case stepTimeInput:
 
 				// Move to next step
 				timeStartErr = ""
 				timeEndErr = ""
+				timeNote = ""
 				m.validate()
 				if timeStartErr != "" || timeEndErr != "" {
 					// Show errors
@@ -328,8 +424,8 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			case stepConfirm:
 				// Submit the time entry and transition to submission state
 				m.submitting = true
+				m.err = nil
 				m.step++
-				lipgloss.Println("DEBUG: submit called")
 				if m.editing {
 					// Updating an existing entry
 					// cmds = append(cmds, m.updateTimeEntry())
@@ -346,9 +442,59 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		}
 
+	case messages.EntrySaveFailedMsg:
+		// A failed save goes back to the confirmation step with the reason shown inline
+		if m.step == stepComplete && m.submitting {
+			m.submitting = false
+			m.err = msg.Err
+			m.step = stepConfirm
+			m.StepLines = getLines(m.viewConfirm())
+		}
+		return m, nil
+
+	case messages.TagsLoadedMsg:
+		m.tags = msg.Tags
+		m.tagsReady = true
+		m.StepLines = getLines(m.viewTagSelect())
+		return m, nil
+
+	case messages.TagCreatedMsg:
+		// Select the tag that was just created from the search
+		m.tags = append(m.tags, msg.Tag)
+		m.selectedTags = append(m.selectedTags, msg.Tag.ID)
+		m.tagSearch.SetValue("")
+		m.cursor = 0
+		m.StepLines = getLines(m.viewTagSelect())
+		return m, nil
+
+	case messages.DayEntriesLoadedMsg:
+		// Ignore entries for a date the user has since moved away from
+		if sameDay(msg.Date, m.calendar.SelectedDate) {
+			m.dayEntries = msg.Entries
+			m.dayLoaded = msg.Date
+		}
+		return m, nil
+
+	case messages.EntryTrimmedMsg:
+		m.dayEntries = slices.Clone(m.dayEntries)
+		for i, entry := range m.dayEntries {
+			if entry.ID == msg.Entry.ID {
+				m.dayEntries[i] = msg.Entry
+			}
+		}
+		m.StepLines = getLines(m.viewConfirm())
+		return m, nil
+
 	case messages.TasksLoadedMsg:
-		m.tasks = msg.Tasks
+		// Revalidated tasks can arrive after switching to another project
+		if msg.ProjectID != m.selectedProj.ID {
+			return m, nil
+		}
+		m.tasks = slices.Clone(msg.Tasks)
 		m.tasks = append(m.tasks, models.Task{ID: "", Name: "No Task"}) // Option for no task
+		if m.step == stepTaskInput {
+			m.cursor = min(m.cursor, len(m.tasks)-1)
+		}
 		m.tasksReady = true
 		m.StepLines = getLines(m.viewTimeInput())
 		return m, nil
@@ -356,7 +502,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	switch m.step {
 	case stepDateSelect:
-		m.calendar, _ = m.calendar.Update(msg)
+		m, cmd = m.updateDateSelect(msg)
 		m.StepLines = getLines(m.viewDateSelect())
 	case stepDescriptionInput:
 		m, cmd = m.updateDescriptionInput(msg)
@@ -369,8 +515,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.StepLines = getLines(m.viewTimeInput())
 	case stepTaskInput:
 		m, cmd = m.updateTaskInput(msg)
+	case stepTagSelect:
+		m, cmd = m.updateTagSelect(msg)
+		m.StepLines = getLines(m.viewTagSelect())
 	case stepConfirm:
-		// m, cmd = m.updateConfirm(msg)
+		m, cmd = m.updateConfirm(msg)
 		m.StepLines = getLines(m.viewConfirm())
 	case stepComplete:
 		m, cmd = m.updateComplete(msg)
@@ -395,6 +544,8 @@ func (m Model) View() tea.View {
 		s += m.viewTimeInput()
 	case stepTaskInput:
 		s += m.viewTaskInput()
+	case stepTagSelect:
+		s += m.viewTagSelect()
 	case stepConfirm:
 		s += m.viewConfirm()
 	case stepComplete:
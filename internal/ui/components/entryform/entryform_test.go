@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"clockify-app/internal/api"
 	"clockify-app/internal/config"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
@@ -72,6 +73,7 @@ func TestUpdateEntry(t *testing.T) {
 			Start: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
 			End:   time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC),
 		},
+		TagIDs: []string{"tag1"},
 	}
 
 	updated := model.UpdateEntry(entry)
@@ -88,6 +90,33 @@ func TestUpdateEntry(t *testing.T) {
 	if updated.selectedProj.ID != "proj1" {
 		t.Errorf("Expected selected project ID 'proj1', got %q", updated.selectedProj.ID)
 	}
+	if len(updated.selectedTags) != 1 || updated.selectedTags[0] != "tag1" {
+		t.Errorf("Expected the entry's tags to be preselected, got %v", updated.selectedTags)
+	}
+}
+
+func TestStartAtForProject(t *testing.T) {
+	projects := []models.Project{
+		{ID: "proj1", Name: "Internal"},
+		{ID: "proj2", Name: "Client Work", IsBillable: true},
+	}
+	start := time.Date(2026, 10, 14, 11, 30, 0, 0, time.Local)
+	model := New(&config.Config{}, projects).StartAt(start, start.Add(time.Hour)).ForProject("proj2")
+
+	if model.timeStart.Value() != "11:30 AM" || model.timeEnd.Value() != "12:30 PM" {
+		t.Errorf("Expected 11:30 AM - 12:30 PM, got %q - %q", model.timeStart.Value(), model.timeEnd.Value())
+	}
+	if !sameDay(model.calendar.SelectedDate, start) {
+		t.Errorf("Expected the calendar on %v, got %v", start, model.calendar.SelectedDate)
+	}
+	if model.selectedProj.ID != "proj2" || model.cursor != 1 || !model.billable {
+		t.Errorf("Expected the billable project to be preselected, got %+v", model.selectedProj)
+	}
+
+	// An unknown project leaves the choice open
+	if model := New(&config.Config{}, projects).ForProject("gone"); model.selectedProj.ID != "" {
+		t.Errorf("Expected no project, got %+v", model.selectedProj)
+	}
 }
 
 func TestFilterProjects(t *testing.T) {
@@ -147,6 +176,33 @@ func TestDateSelectUpdate(t *testing.T) {
 	}
 }
 
+func TestTypedDate(t *testing.T) {
+	model := New(&config.Config{}, []models.Project{})
+	yesterday := time.Now().AddDate(0, 0, -1)
+
+	for _, r := range "yesterday" {
+		model, _ = model.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	if got := model.calendar.SelectedDate; got.YearDay() != yesterday.YearDay() || got.Year() != yesterday.Year() {
+		t.Errorf("Expected the calendar to follow the typed date, got %v", got)
+	}
+
+	// Arrow keys still move the calendar and clear what was typed
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	if model.dateInput.Value() != "" {
+		t.Errorf("Expected the typed date to be cleared, got %q", model.dateInput.Value())
+	}
+
+	// An unknown date blocks the next step
+	for _, r := range "someday" {
+		model, _ = model.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if model.step != stepDateSelect || model.dateErr == "" {
+		t.Errorf("Expected to stay on the date step with an error, got step %d", model.step)
+	}
+}
+
 func TestStepNavigation(t *testing.T) {
 	model := New(&config.Config{}, []models.Project{})
 
@@ -186,6 +242,356 @@ func TestTasksLoadedMessage(t *testing.T) {
 	}
 }
 
+func TestTagSelect(t *testing.T) {
+	model := New(&config.Config{}, []models.Project{})
+	model.step = stepTagSelect
+
+	tags := []models.Tag{
+		{ID: "tag1", Name: "Meeting"},
+		{ID: "tag2", Name: "Development"},
+	}
+	model, _ = model.Update(messages.TagsLoadedMsg{Tags: tags})
+	if !model.tagsReady {
+		t.Fatal("tagsReady should be true after tags loaded")
+	}
+
+	// Select the second tag, then toggle the first on and off again
+	space := tea.KeyPressMsg{Code: tea.KeySpace, Text: " "}
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	model, _ = model.Update(space)
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	model, _ = model.Update(space)
+	model, _ = model.Update(space)
+
+	if len(model.selectedTags) != 1 || model.selectedTags[0] != "tag2" {
+		t.Errorf("Expected only tag2 selected, got %v", model.selectedTags)
+	}
+	if !strings.Contains(model.viewConfirm(), "Development") {
+		t.Error("Confirm step should list the selected tag names")
+	}
+
+	// Enter moves on to the time input
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if model.step != stepTimeInput {
+		t.Errorf("Expected step %d after enter, got %d", stepTimeInput, model.step)
+	}
+}
+
+func TestTagSearchCreatesTag(t *testing.T) {
+	model := New(&config.Config{}, []models.Project{})
+	model.step = stepTagSelect
+	model, _ = model.Update(messages.TagsLoadedMsg{Tags: []models.Tag{{ID: "tag1", Name: "Meeting"}}})
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	for _, r := range "Support" {
+		model, _ = model.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	if len(model.filterTags()) != 0 {
+		t.Fatalf("Expected no matches for a new tag, got %v", model.filterTags())
+	}
+
+	model, cmd := model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Enter on an unknown name should create the tag")
+	}
+	if model.step != stepTagSelect {
+		t.Errorf("Creating a tag should stay on the tag step, got %d", model.step)
+	}
+
+	model, _ = model.Update(messages.TagCreatedMsg{Tag: models.Tag{ID: "tag2", Name: "Support"}})
+	if len(model.selectedTags) != 1 || model.selectedTags[0] != "tag2" {
+		t.Errorf("Expected the created tag to be selected, got %v", model.selectedTags)
+	}
+	if model.tagSearch.Value() != "" {
+		t.Error("Search should be cleared after creating a tag")
+	}
+}
+
+func TestBillable(t *testing.T) {
+	projects := []models.Project{
+		{ID: "proj1", Name: "Internal"},
+		{ID: "proj2", Name: "Client Work", IsBillable: true},
+	}
+	model := New(&config.Config{}, projects)
+	model.step = stepProjectSelect
+
+	// Picking a billable project makes the entry billable
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !model.billable {
+		t.Fatal("Expected billable to default from the project")
+	}
+
+	// The confirm step toggles it
+	model.step = stepConfirm
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	if model.billable {
+		t.Error("Expected b to toggle billable off")
+	}
+	if !strings.Contains(model.viewConfirm(), "Billable: No") {
+		t.Error("Confirm step should show the billable state")
+	}
+
+	// Editing keeps the entry's own flag while the project is unchanged
+	edited := New(&config.Config{}, projects).UpdateEntry(models.Entry{ProjectID: "proj2", Billable: false})
+	edited.step = stepProjectSelect
+	edited, _ = edited.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if edited.billable {
+		t.Error("Reselecting the same project should keep the entry's billable flag")
+	}
+}
+
+func TestEntryChanges(t *testing.T) {
+	projects := []models.Project{{ID: "proj1", Name: "Project 1"}}
+	entry := models.Entry{
+		ID:          "entry1",
+		Description: "Planning",
+		ProjectID:   "proj1",
+		TaskID:      "task1",
+		TagIDs:      []string{"tag1"},
+		Billable:    true,
+		TimeInterval: models.IntervalTime{
+			Start: time.Date(2024, 1, 15, 9, 0, 30, 0, time.Local),
+			End:   time.Date(2024, 1, 15, 10, 0, 0, 0, time.Local),
+		},
+	}
+	model := New(&config.Config{}, projects).UpdateEntry(entry)
+
+	// Nothing touched, nothing sent
+	changes, err := model.entryChanges()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if changes.Description != nil || changes.ProjectID != nil || changes.TaskID != nil ||
+		changes.TagIDs != nil || changes.Billable != nil || changes.Start != nil || changes.End != nil {
+		t.Errorf("Expected no changes for an untouched entry, got %+v", changes)
+	}
+
+	model.description.SetValue("Sprint planning")
+	model.timeEnd.SetValue("10:30 AM")
+	model.selectedTags = nil
+
+	changes, err = model.entryChanges()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if changes.Description == nil || *changes.Description != "Sprint planning" {
+		t.Errorf("Expected the description change, got %v", changes.Description)
+	}
+	if changes.End == nil || changes.End.Minute() != 30 {
+		t.Errorf("Expected the end change, got %v", changes.End)
+	}
+	if changes.TagIDs == nil || len(changes.TagIDs) != 0 {
+		t.Errorf("Removing every tag should send an empty list, got %#v", changes.TagIDs)
+	}
+	if changes.Start != nil || changes.TaskID != nil {
+		t.Error("Untouched fields should not be sent")
+	}
+}
+
+func TestTimeRangeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		wantErr    string
+	}{
+		{"valid", "9:00 AM", "10:30 AM", ""},
+		{"zero length", "9:00 AM", "9:00 AM", "Entry must be at least one minute long."},
+		{"duration end", "9:00 AM", "+1h30", ""},
+		{"decimal duration end", "930a", "1.5h", ""},
+		{"zero duration", "9:00 AM", "0m", "Entry must be at least one minute long."},
+		{"bad duration", "9:00 AM", "+soon", "Invalid end time format. Use HH:MM or a duration like +1h30."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := New(&config.Config{}, nil)
+			model.step = stepTimeInput
+			model.timeStart.SetValue(tt.start)
+			model.timeEnd.SetValue(tt.end)
+
+			model, cmd := model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+			if tt.wantErr == "" {
+				if model.step != stepConfirm {
+					t.Errorf("Expected the confirm step, got %d", model.step)
+				}
+				return
+			}
+			if model.step != stepTimeInput || cmd != nil {
+				t.Error("An invalid range should stay on the time step without sending anything")
+			}
+			if timeEndErr != tt.wantErr {
+				t.Errorf("Expected %q, got %q", tt.wantErr, timeEndErr)
+			}
+		})
+	}
+}
+
+func TestOvernightEntry(t *testing.T) {
+	model := New(&config.Config{}, nil)
+	model.calendar.SetSelectedDay(time.Date(2024, 1, 15, 0, 0, 0, 0, time.Local))
+	model.step = stepTimeInput
+	model.timeStart.SetValue("10:00 PM")
+	model.timeEnd.SetValue("2:00 AM")
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if model.step != stepConfirm {
+		t.Fatalf("Expected the confirm step, got %d (%s)", model.step, timeEndErr)
+	}
+
+	r, err := model.timeRange()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if r.Duration() != 4*time.Hour || r.End.Day() != 16 {
+		t.Errorf("Expected the end to roll to the next morning, got %v to %v", r.Start, r.End)
+	}
+	if !strings.Contains(model.viewConfirm(), "(next day)") {
+		t.Error("Confirmation should say the entry ends the next day")
+	}
+
+	// Editing an overnight entry without touching the times changes nothing
+	entry := models.Entry{
+		ID: "entry1",
+		TimeInterval: models.IntervalTime{
+			Start: r.Start,
+			End:   r.End,
+		},
+	}
+	changes, err := New(&config.Config{}, nil).UpdateEntry(entry).entryChanges()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if changes.Start != nil || changes.End != nil {
+		t.Errorf("Expected no time changes, got %v to %v", changes.Start, changes.End)
+	}
+}
+
+func TestEditRunningEntry(t *testing.T) {
+	entry := models.Entry{
+		ID:     "running",
+		TagIDs: []string{"tag1", "tag2"},
+		TimeInterval: models.IntervalTime{
+			Start: time.Date(2024, 1, 15, 9, 0, 0, 0, time.Local),
+		},
+	}
+	model := New(&config.Config{}, nil).UpdateEntry(entry)
+
+	// The empty end is accepted, and the entry keeps running
+	timeStartErr, timeEndErr = "", ""
+	model.validate()
+	if timeStartErr != "" || timeEndErr != "" {
+		t.Fatalf("Expected a running entry to validate without an end, got %q %q", timeStartErr, timeEndErr)
+	}
+	if !strings.Contains(model.viewConfirm(), "9:00 AM - running") {
+		t.Error("Confirmation should show the entry still running")
+	}
+
+	// Tags picked in another order are the same tags
+	model.selectedTags = []string{"tag2", "tag1"}
+	model.timeStart.SetValue("8:30 AM")
+	changes, err := model.entryChanges()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if changes.TagIDs != nil {
+		t.Errorf("Expected reordered tags to be left alone, got %v", changes.TagIDs)
+	}
+	if changes.Start == nil || changes.Start.Hour() != 8 || changes.End != nil {
+		t.Errorf("Expected only the start to move, got %v to %v", changes.Start, changes.End)
+	}
+
+	// Entering an end stops it
+	model.timeEnd.SetValue("10:00 AM")
+	changes, err = model.entryChanges()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if changes.End == nil || changes.End.Hour() != 10 {
+		t.Errorf("Expected the entered end, got %v", changes.End)
+	}
+}
+
+func TestOverlapsAndTrim(t *testing.T) {
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.Local)
+	at := func(hour, min int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+
+	model := New(&config.Config{}, nil)
+	model.calendar.SetSelectedDay(day)
+	model, _ = model.Update(messages.DayEntriesLoadedMsg{Date: day, Entries: []models.Entry{
+		{ID: "before", Description: "Standup", TimeInterval: models.IntervalTime{Start: at(9, 0), End: at(10, 30)}},
+		{ID: "inside", Description: "Call", TimeInterval: models.IntervalTime{Start: at(10, 15), End: at(10, 45)}},
+		{ID: "apart", Description: "Lunch", TimeInterval: models.IntervalTime{Start: at(12, 0), End: at(13, 0)}},
+	}})
+	model.step = stepConfirm
+	model.timeStart.SetValue("10:00 AM")
+	model.timeEnd.SetValue("11:00 AM")
+
+	if overlapping := model.overlaps(); len(overlapping) != 2 {
+		t.Fatalf("Expected 2 overlapping entries, got %v", overlapping)
+	}
+	if view := model.viewConfirm(); !strings.Contains(view, `Overlaps "Standup"`) || !strings.Contains(view, "[x] trim") {
+		t.Errorf("Expected an overlap warning with the trim action, got:\n%s", view)
+	}
+
+	// Only the entry that starts first can be trimmed, back to the new start
+	trims := model.trims()
+	changes, ok := trims["before"]
+	if len(trims) != 1 || !ok || changes.End == nil || !changes.End.Equal(at(10, 0)) || changes.Start != nil {
+		t.Errorf("Expected to trim the end of 'before' to 10:00, got %+v", trims)
+	}
+	if _, cmd := model.updateConfirm(tea.KeyPressMsg{Code: 'x', Text: "x"}); cmd == nil {
+		t.Error("Expected x to trim the overlapping entry")
+	}
+
+	// Once trimmed, the warning goes away for that entry
+	model, _ = model.Update(messages.EntryTrimmedMsg{Entry: models.Entry{
+		ID: "before", Description: "Standup", TimeInterval: models.IntervalTime{Start: at(9, 0), End: at(10, 0)},
+	}})
+	if overlapping := model.overlaps(); len(overlapping) != 1 || overlapping[0].ID != "inside" {
+		t.Errorf("Expected only 'inside' to overlap, got %v", overlapping)
+	}
+}
+
+func TestFillGap(t *testing.T) {
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.Local)
+	at := func(hour, min int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+
+	model := New(&config.Config{}, nil)
+	model.calendar.SetSelectedDay(day)
+	model.step = stepTimeInput
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'g', Mod: tea.ModCtrl})
+	if timeNote != "Still loading the day's entries..." {
+		t.Errorf("Expected a loading note, got %q", timeNote)
+	}
+
+	model, _ = model.Update(messages.DayEntriesLoadedMsg{Date: day, Entries: []models.Entry{
+		{ID: "a", TimeInterval: models.IntervalTime{Start: at(9, 0), End: at(10, 0).Add(20 * time.Second)}},
+		{ID: "b", TimeInterval: models.IntervalTime{Start: at(11, 0), End: at(12, 0)}},
+		{ID: "c", TimeInterval: models.IntervalTime{Start: at(15, 0), End: at(16, 0)}},
+	}})
+
+	// Nearest the typed start
+	model.timeStart.SetValue("2p")
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'g', Mod: tea.ModCtrl})
+	if model.timeStart.Value() != "12:00 PM" || model.timeEnd.Value() != "3:00 PM" {
+		t.Errorf("Expected the 12-3 gap, got %s - %s", model.timeStart.Value(), model.timeEnd.Value())
+	}
+
+	// Seconds are rounded into the gap
+	model.timeStart.SetValue("10:30 AM")
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'g', Mod: tea.ModCtrl})
+	if model.timeStart.Value() != "10:01 AM" || model.timeEnd.Value() != "11:00 AM" {
+		t.Errorf("Expected the 10:01-11 gap, got %s - %s", model.timeStart.Value(), model.timeEnd.Value())
+	}
+}
+
 func TestViewMethods(t *testing.T) {
 	model := New(&config.Config{}, []models.Project{})
 
@@ -267,3 +673,27 @@ func TestEscapeKey(t *testing.T) {
 		t.Error("Cursor should reset to 0")
 	}
 }
+
+func TestSubmitErrorReturnsToConfirm(t *testing.T) {
+	model := New(&config.Config{APIKey: "test", WorkspaceId: "ws1"}, []models.Project{})
+	model.step = stepComplete
+	model.submitting = true
+
+	err := &api.APIError{StatusCode: 400, Code: 501, Message: "End time must be after start time"}
+	// Other errors, like a failed background fetch, don't touch the form
+	updated, _ := model.Update(messages.ErrorMsg{Err: err})
+	if updated.step != stepComplete || !updated.submitting {
+		t.Fatal("An unrelated error shouldn't be taken for the failed save")
+	}
+
+	updated, _ = model.Update(messages.EntrySaveFailedMsg{Err: err})
+	if updated.step != stepConfirm {
+		t.Errorf("Expected step %d after a failed save, got %d", stepConfirm, updated.step)
+	}
+	if updated.submitting {
+		t.Error("submitting should be cleared after a failed save")
+	}
+	if !strings.Contains(updated.viewConfirm(), "End time must be after start time") {
+		t.Error("Confirm step should show the validation message")
+	}
+}
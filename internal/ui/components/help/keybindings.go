@@ -10,6 +10,8 @@ type GlobalKeyMap struct {
 	Navigation key.Binding
 	Help       key.Binding
 	Quit       key.Binding
+	StopTimer  key.Binding
+	ErrorLog   key.Binding
 	Up         key.Binding
 	Down       key.Binding
 	Esc        key.Binding
@@ -28,6 +30,14 @@ var Global = GlobalKeyMap{
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q/<ctrl+c>", "Quit"),
 	),
+	StopTimer: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "Stop running timer"),
+	),
+	ErrorLog: key.NewBinding(
+		key.WithKeys("!"),
+		key.WithHelp("!", "Show error log"),
+	),
 	Up: key.NewBinding(
 		key.WithKeys("up", "k"),
 		key.WithHelp("↑/k", "Move Viewport up"),
@@ -50,6 +60,7 @@ type EntryKeyMap struct {
 	Delete key.Binding
 	Edit   key.Binding
 	Copy   key.Binding
+	Timer  key.Binding
 	New    key.Binding
 	Up     key.Binding
 	Down   key.Binding
@@ -95,6 +106,10 @@ var Entry = EntryKeyMap{
 		key.WithKeys("d"),
 		key.WithHelp("d", "Delete entry"),
 	),
+	Timer: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "Start timer from entry"),
+	),
 }
 
 // =======================================
@@ -154,26 +169,221 @@ var Project = ProjectKeyMap{
 type WeekKeyMap struct {
 	PreviousWeek key.Binding
 	NextWeek     key.Binding
+	ThisWeek     key.Binding
+	Left         key.Binding
+	Right        key.Binding
 	Up           key.Binding
 	Down         key.Binding
+	Entries      key.Binding
+	Add          key.Binding
+	Edit         key.Binding
+	Copy         key.Binding
+	Delete       key.Binding
 }
 
 var Week = WeekKeyMap{
 	PreviousWeek: key.NewBinding(
-		key.WithKeys("h", "left"),
-		key.WithHelp("←/h", "Previous Week"),
+		key.WithKeys("[", "H"),
+		key.WithHelp("[/H", "Previous Week"),
 	),
 	NextWeek: key.NewBinding(
+		key.WithKeys("]", "L"),
+		key.WithHelp("]/L", "Next Week"),
+	),
+	ThisWeek: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "This Week"),
+	),
+	Left: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "Previous day"),
+	),
+	Right: key.NewBinding(
+		key.WithKeys("right", "l"),
+		key.WithHelp("→/l", "Next day"),
+	),
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "Previous project"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "Next project"),
+	),
+	Entries: key.NewBinding(
+		key.WithKeys("enter", "tab"),
+		key.WithHelp("<enter>/<tab>", "Browse the cell's entries (esc to return)"),
+	),
+	Add: key.NewBinding(
+		key.WithKeys("n", "a"),
+		key.WithHelp("n/a", "Add time to the cell"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "Edit entry"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "Copy entry"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "Delete entry"),
+	),
+}
+
+// =======================================
+// Month Key Bindings
+// =======================================
+
+type MonthKeyMap struct {
+	PreviousMonth key.Binding
+	NextMonth     key.Binding
+	Today         key.Binding
+	Left          key.Binding
+	Right         key.Binding
+	Up            key.Binding
+	Down          key.Binding
+	Entries       key.Binding
+	Edit          key.Binding
+	Copy          key.Binding
+	Delete        key.Binding
+}
+
+var Month = MonthKeyMap{
+	PreviousMonth: key.NewBinding(
+		key.WithKeys("[", "H"),
+		key.WithHelp("[/H", "Previous Month"),
+	),
+	NextMonth: key.NewBinding(
+		key.WithKeys("]", "L"),
+		key.WithHelp("]/L", "Next Month"),
+	),
+	Today: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "Today"),
+	),
+	Left: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "Previous day"),
+	),
+	Right: key.NewBinding(
+		key.WithKeys("right", "l"),
+		key.WithHelp("→/l", "Next day"),
+	),
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "Week before"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "Week after"),
+	),
+	Entries: key.NewBinding(
+		key.WithKeys("enter", "tab"),
+		key.WithHelp("<enter>/<tab>", "Browse the day's entries (esc to return)"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "Edit entry"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "Copy entry"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "Delete entry"),
+	),
+}
+
+// =======================================
+// Day Key Bindings
+// =======================================
+
+type DayKeyMap struct {
+	PreviousDay key.Binding
+	NextDay     key.Binding
+	Today       key.Binding
+	Up          key.Binding
+	Down        key.Binding
+	Edit        key.Binding
+	Copy        key.Binding
+	Delete      key.Binding
+	New         key.Binding
+}
+
+var Day = DayKeyMap{
+	PreviousDay: key.NewBinding(
+		key.WithKeys("h", "left"),
+		key.WithHelp("←/h", "Previous Day"),
+	),
+	NextDay: key.NewBinding(
 		key.WithKeys("l", "right"),
-		key.WithHelp("→/l", "Next Week"),
+		key.WithHelp("→/l", "Next Day"),
+	),
+	Today: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "Today"),
 	),
 	Up: key.NewBinding(
 		key.WithKeys("up", "k"),
-		key.WithHelp("↑/k", "Move up"),
+		key.WithHelp("↑/k", "Earlier slot"),
 	),
 	Down: key.NewBinding(
 		key.WithKeys("down", "j"),
-		key.WithHelp("↓/j", "Move down"),
+		key.WithHelp("↓/j", "Later slot"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("e", "enter"),
+		key.WithHelp("e/<enter>", "Edit entry"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "Copy entry"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "Delete entry"),
+	),
+	New: key.NewBinding(
+		key.WithKeys("n", "enter"),
+		key.WithHelp("n/<enter>", "New entry in a free slot"),
+	),
+}
+
+// =======================================
+// Reports Key Bindings
+// =======================================
+
+type ReportsKeyMap struct {
+	PreviousPeriod key.Binding
+	NextPeriod     key.Binding
+	Range          key.Binding
+	Group          key.Binding
+	Custom         key.Binding
+}
+
+var Reports = ReportsKeyMap{
+	PreviousPeriod: key.NewBinding(
+		key.WithKeys("h", "left"),
+		key.WithHelp("←/h", "Previous Period"),
+	),
+	NextPeriod: key.NewBinding(
+		key.WithKeys("l", "right"),
+		key.WithHelp("→/l", "Next Period"),
+	),
+	Range: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "Cycle Week/Month/Year"),
+	),
+	Group: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "Cycle Grouping"),
+	),
+	Custom: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "Custom Date Range"),
 	),
 }
 
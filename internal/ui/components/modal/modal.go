@@ -8,9 +8,11 @@ import (
 	"clockify-app/internal/ui/components/confirmation"
 	"clockify-app/internal/ui/components/entryform"
 	"clockify-app/internal/ui/components/help"
+	"clockify-app/internal/ui/components/notify"
 	"clockify-app/internal/utils"
 
 	"strings"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -22,6 +24,7 @@ const (
 	EntryModal ModalType = iota
 	DeleteConfirmation
 	HelpModal
+	ErrorLogModal
 )
 
 type Model struct {
@@ -29,6 +32,7 @@ type Model struct {
 	entryForm          *entryform.Model
 	help               *help.Model
 	deleteConfirmation *confirmation.Model
+	errorLog           []notify.Notification
 	// UI
 	scrollOffset int
 	title        string
@@ -45,6 +49,19 @@ func NewEntryForm(cfg *config.Config, projects []models.Project) *Model {
 	}
 }
 
+// NewEntryFormAt opens a new entry with its date, times and optionally its project already filled in
+func NewEntryFormAt(cfg *config.Config, projects []models.Project, start, end time.Time, projectID string) *Model {
+	form := entryform.New(cfg, projects)
+	form = form.StartAt(start, end).ForProject(projectID)
+
+	return &Model{
+		modalType:    EntryModal,
+		entryForm:    &form,
+		title:        "New Entry",
+		scrollOffset: 0,
+	}
+}
+
 func UpdateEntryForm(cfg *config.Config, projects []models.Project, entry models.Entry) *Model {
 	form := entryform.New(cfg, projects)
 	form = form.UpdateEntry(entry)
@@ -90,6 +107,15 @@ func NewHelp(sections ...help.HelpSection) *Model {
 	}
 }
 
+func NewErrorLog(log []notify.Notification) *Model {
+	return &Model{
+		modalType:    ErrorLogModal,
+		errorLog:     log,
+		title:        "Error Log",
+		scrollOffset: 0,
+	}
+}
+
 func (m Model) Init() tea.Cmd {
 	switch m.modalType {
 	case EntryModal:
@@ -258,6 +284,8 @@ func (m Model) RenderContent() string {
 		return m.deleteConfirmation.View().Content
 	case HelpModal:
 		return m.help.View().Content
+	case ErrorLogModal:
+		return notify.LogView(m.errorLog)
 	}
 	return "MODAL"
 }
@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/styles"
+	"errors"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+const (
+	MaxVisible    = 3  // Toasts shown at once, older ones wait for the newer to be dismissed
+	MaxLogEntries = 50 // Failures kept for the error log
+)
+
+// How long each level stays on screen
+var lifetimes = map[messages.NotifyLevel]time.Duration{
+	messages.NotifyInfo:    3 * time.Second,
+	messages.NotifySuccess: 3 * time.Second,
+	messages.NotifyWarning: 5 * time.Second,
+	messages.NotifyError:   8 * time.Second,
+}
+
+type Notification struct {
+	ID    int
+	Level messages.NotifyLevel
+	Text  string
+	Time  time.Time
+}
+
+// DismissMsg removes a toast once its lifetime is up
+type DismissMsg struct {
+	ID int
+}
+
+type Model struct {
+	active []Notification // Toasts on screen, oldest first
+	log    []Notification // Errors and warnings, oldest first
+	nextID int
+	now    func() time.Time
+}
+
+func New() Model {
+	return Model{now: time.Now}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case messages.ErrorMsg:
+		return m, m.Push(errorLevel(msg.Err), api.ErrorMessage(msg.Err))
+
+	case messages.NotifyMsg:
+		return m, m.Push(msg.Level, msg.Text)
+
+	case DismissMsg:
+		for i, n := range m.active {
+			if n.ID == msg.ID {
+				m.active = append(m.active[:i:i], m.active[i+1:]...)
+				break
+			}
+		}
+	}
+	return m, nil
+}
+
+// Push shows a toast and returns the command that dismisses it
+// Errors and warnings are also added to the error log
+func (m *Model) Push(level messages.NotifyLevel, text string) tea.Cmd {
+	if m.now == nil {
+		m.now = time.Now
+	}
+
+	m.nextID++
+	n := Notification{
+		ID:    m.nextID,
+		Level: level,
+		Text:  text,
+		Time:  m.now(),
+	}
+	m.active = append(m.active, n)
+
+	if level >= messages.NotifyWarning {
+		m.log = append(m.log, n)
+		if len(m.log) > MaxLogEntries {
+			m.log = m.log[len(m.log)-MaxLogEntries:]
+		}
+	}
+
+	id := n.ID
+	return tea.Tick(lifetimes[level], func(time.Time) tea.Msg {
+		return DismissMsg{ID: id}
+	})
+}
+
+// errorLevel downgrades failures the user can simply wait out
+func errorLevel(err error) messages.NotifyLevel {
+	if errors.Is(err, api.ErrRateLimited) {
+		return messages.NotifyWarning
+	}
+	return messages.NotifyError
+}
+
+// Active returns the toasts currently on screen
+func (m Model) Active() []Notification {
+	return m.active
+}
+
+// Log returns logged errors and warnings, newest first
+func (m Model) Log() []Notification {
+	log := make([]Notification, len(m.log))
+	for i, n := range m.log {
+		log[len(m.log)-1-i] = n
+	}
+	return log
+}
+
+// View renders the newest toasts, one per line
+// Returns an empty string when there is nothing to show
+func (m Model) View(width int) string {
+	visible := m.active
+	if len(visible) > MaxVisible {
+		visible = visible[len(visible)-MaxVisible:]
+	}
+
+	lines := make([]string, 0, len(visible))
+	for _, n := range visible {
+		lines = append(lines, styles.ToastStyle.Width(width).MaxHeight(1).Render(Render(n)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Render styles a notification with its level's icon and colour
+func Render(n Notification) string {
+	switch n.Level {
+	case messages.NotifySuccess:
+		return styles.SuccessStyle.Render("✓ " + n.Text)
+	case messages.NotifyWarning:
+		return styles.WarningStyle.Render("⚠ " + n.Text)
+	case messages.NotifyError:
+		return styles.ErrorStyle.Render("✗ " + n.Text)
+	default:
+		return styles.InfoStyle.Render("ℹ " + n.Text)
+	}
+}
+
+// LogView renders the error log with timestamps, for the error log modal
+func LogView(log []Notification) string {
+	if len(log) == 0 {
+		return styles.MutedTextStyle.Render("No errors so far.")
+	}
+
+	lines := make([]string, 0, len(log))
+	for _, n := range log {
+		lines = append(lines, lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			styles.MutedTextStyle.MarginRight(2).Render(n.Time.Format("15:04:05")),
+			Render(n),
+		))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,123 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"clockify-app/internal/api"
+	"clockify-app/internal/messages"
+)
+
+func newTestModel() Model {
+	m := New()
+	m.now = func() time.Time {
+		return time.Date(2026, 3, 4, 9, 30, 15, 0, time.Local)
+	}
+	return m
+}
+
+func TestPushAndDismiss(t *testing.T) {
+	m := newTestModel()
+
+	cmd := m.Push(messages.NotifySuccess, "Entry saved")
+	if cmd == nil {
+		t.Fatal("Push should return a dismiss command")
+	}
+	if len(m.Active()) != 1 {
+		t.Fatalf("Expected 1 active toast, got %d", len(m.Active()))
+	}
+	if len(m.Log()) != 0 {
+		t.Error("Successes should not be logged")
+	}
+
+	id := m.Active()[0].ID
+	m, _ = m.Update(DismissMsg{ID: id})
+	if len(m.Active()) != 0 {
+		t.Errorf("Expected toast to be dismissed, %d left", len(m.Active()))
+	}
+	if m.View(80) != "" {
+		t.Error("View should be empty with no toasts")
+	}
+}
+
+func TestDismissOnlyRemovesMatchingToast(t *testing.T) {
+	m := newTestModel()
+	m.Push(messages.NotifyInfo, "first")
+	m.Push(messages.NotifyInfo, "second")
+
+	m, _ = m.Update(DismissMsg{ID: m.Active()[0].ID})
+
+	if len(m.Active()) != 1 || m.Active()[0].Text != "second" {
+		t.Errorf("Expected only the second toast to remain, got %+v", m.Active())
+	}
+}
+
+func TestErrorMsgIsLogged(t *testing.T) {
+	m := newTestModel()
+
+	err := fmt.Errorf("save: %w", errors.New("connection refused"))
+	m, cmd := m.Update(messages.ErrorMsg{Err: err})
+	if cmd == nil {
+		t.Fatal("Expected a dismiss command")
+	}
+
+	log := m.Log()
+	if len(log) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d", len(log))
+	}
+	if log[0].Level != messages.NotifyError {
+		t.Errorf("Expected error level, got %d", log[0].Level)
+	}
+	if !strings.Contains(LogView(log), "09:30:15") {
+		t.Error("Log view should include the timestamp")
+	}
+}
+
+func TestAPIErrorsShowClockifyMessage(t *testing.T) {
+	m := newTestModel()
+
+	m, _ = m.Update(messages.ErrorMsg{Err: &api.APIError{StatusCode: 400, Message: "Project is archived"}})
+	m, _ = m.Update(messages.ErrorMsg{Err: &api.APIError{StatusCode: 429, Message: "Too many requests"}})
+
+	active := m.Active()
+	if active[0].Text != "Project is archived" {
+		t.Errorf("Expected Clockify's message, got %q", active[0].Text)
+	}
+	if active[1].Level != messages.NotifyWarning {
+		t.Errorf("Rate limiting should be a warning, got level %d", active[1].Level)
+	}
+
+	// Newest first
+	if log := m.Log(); log[0].Text != "Too many requests" {
+		t.Errorf("Expected newest log entry first, got %q", log[0].Text)
+	}
+}
+
+func TestViewLimitsVisibleToasts(t *testing.T) {
+	m := newTestModel()
+	for i := range MaxVisible + 2 {
+		m.Push(messages.NotifyInfo, fmt.Sprintf("toast %d", i))
+	}
+
+	view := m.View(80)
+	if lines := strings.Count(view, "\n") + 1; lines != MaxVisible {
+		t.Errorf("Expected %d visible lines, got %d", MaxVisible, lines)
+	}
+	if strings.Contains(view, "toast 0") {
+		t.Error("Oldest toasts should be hidden")
+	}
+}
+
+func TestLogIsCapped(t *testing.T) {
+	m := newTestModel()
+	for i := range MaxLogEntries + 5 {
+		m.Push(messages.NotifyError, fmt.Sprintf("error %d", i))
+	}
+
+	if len(m.Log()) != MaxLogEntries {
+		t.Errorf("Expected log capped at %d, got %d", MaxLogEntries, len(m.Log()))
+	}
+}
@@ -0,0 +1,427 @@
+package day
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// SlotLength is the stretch of time each row of the timeline covers
+var SlotLength = 30 * time.Minute
+
+// The timeline always shows these hours and grows to fit entries outside them
+var (
+	DefaultStartHour = 8
+	DefaultEndHour   = 18
+)
+
+var (
+	DayStyle = lipgloss.NewStyle().Padding(1, 2)
+
+	headerStyle = lipgloss.NewStyle().
+			Foreground(styles.Primary).
+			Bold(true)
+
+	hourStyle  = lipgloss.NewStyle().Foreground(styles.Secondary)
+	gapStyle   = lipgloss.NewStyle().Foreground(styles.Muted)
+	nowStyle   = lipgloss.NewStyle().Foreground(styles.Warning).Bold(true)
+	blockStyle = lipgloss.NewStyle().Foreground(styles.Background).Padding(0, 1)
+)
+
+// slot is one row of the timeline. Entries sharing a half hour each get a row of it.
+type slot struct {
+	start   time.Time
+	end     time.Time
+	entry   *models.Entry // nil for free time
+	first   bool          // First row of the entry, where its label goes and the cursor stops
+	stacked bool          // Not the half hour's first row, so its time isn't repeated
+}
+
+// selectable reports whether the cursor stops on the slot: free time or the start of an entry
+func (s slot) selectable() bool {
+	return s.entry == nil || s.first
+}
+
+type Model struct {
+	config      *config.Config
+	entries     []models.Entry
+	projects    []models.Project
+	date        time.Time          // Midnight of the displayed day
+	cursor      int                // Selected row, -1 until the day is loaded
+	cancelFetch context.CancelFunc // Cancels the in-flight fetch when paging
+	now         func() time.Time
+	width       int
+	height      int
+	ready       bool
+}
+
+func New(cfg *config.Config) Model {
+	m := Model{
+		config:  cfg,
+		entries: []models.Entry{},
+		cursor:  -1,
+		now:     time.Now,
+	}
+	m.date = startOfDay(m.now())
+	return m
+}
+
+// Init fetches the displayed day, which stays put when switching views
+func (m *Model) Init() tea.Cmd {
+	return m.fetchDay()
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// Refresh fetches the displayed day again, after an entry was saved or deleted
+func (m *Model) Refresh() tea.Cmd {
+	return m.fetchDay()
+}
+
+func (m *Model) PreviousDay() tea.Cmd {
+	return m.showDay(m.date.AddDate(0, 0, -1))
+}
+
+func (m *Model) NextDay() tea.Cmd {
+	return m.showDay(m.date.AddDate(0, 0, 1))
+}
+
+func (m *Model) showDay(day time.Time) tea.Cmd {
+	m.date = startOfDay(day)
+	m.cursor = -1
+	m.ready = false
+	return m.fetchDay()
+}
+
+// fetchDay fetches the displayed day, cancelling any fetch still in flight
+func (m *Model) fetchDay() tea.Cmd {
+	if m.cancelFetch != nil {
+		m.cancelFetch()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelFetch = cancel
+
+	start, end := api.DayRange(m.date)
+	return api.FetchEntriesForRange(
+		ctx,
+		m.config.APIKey,
+		m.config.WorkspaceId,
+		m.config.UserId,
+		start,
+		end,
+	)
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		slots := m.slots()
+
+		switch msg.String() {
+		case "h", "left":
+			cmds = append(cmds, m.PreviousDay())
+		case "l", "right":
+			cmds = append(cmds, m.NextDay())
+		case "t":
+			cmds = append(cmds, m.showDay(m.now()))
+		case "j", "down":
+			for i := m.cursor + 1; i < len(slots); i++ {
+				if slots[i].selectable() {
+					m.cursor = i
+					break
+				}
+			}
+		case "k", "up":
+			for i := m.cursor - 1; i >= 0; i-- {
+				if slots[i].selectable() {
+					m.cursor = i
+					break
+				}
+			}
+		case "e", "c", "d", "enter", "n":
+			if m.cursor >= 0 && m.cursor < len(slots) {
+				cmds = append(cmds, slotAction(slots[m.cursor], msg.String()))
+			}
+		}
+
+	case messages.EntriesLoadedMsg:
+		// Drop responses for days we've already paged away from
+		if !msg.ForRange(api.DayRange(m.date)) {
+			break
+		}
+		m.entries = msg.Entries
+		m.ready = true
+		m.cursor = m.initialCursor()
+
+	case messages.ProjectsLoadedMsg:
+		m.projects = msg.Projects
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// slotAction opens the edit, copy or delete flow for an entry, or a new entry for free time
+func slotAction(s slot, key string) tea.Cmd {
+	if s.entry == nil {
+		if key != "enter" && key != "n" {
+			return nil
+		}
+		return func() tea.Msg {
+			return messages.EntryCreateStartedMsg{Start: s.start, End: s.end}
+		}
+	}
+
+	entry := *s.entry
+	switch key {
+	case "e", "enter":
+		return func() tea.Msg {
+			return messages.EntryUpdateStartedMsg{Entry: entry}
+		}
+	case "c":
+		return func() tea.Msg {
+			return messages.EntryCopyStartedMsg{Entry: entry}
+		}
+	case "d":
+		return func() tea.Msg {
+			return messages.EntryDeleteStartedMsg{EntryId: entry.ID}
+		}
+	}
+	return nil
+}
+
+// initialCursor keeps the selection on a reload, otherwise it starts at now or the first entry
+func (m Model) initialCursor() int {
+	slots := m.slots()
+	if m.cursor >= 0 {
+		return selectableAt(slots, min(m.cursor, len(slots)-1))
+	}
+
+	now := m.now()
+	for i, s := range slots {
+		if !now.Before(s.start) && now.Before(s.end) {
+			return selectableAt(slots, i)
+		}
+	}
+	for i, s := range slots {
+		if s.entry != nil {
+			return i
+		}
+	}
+	return 0
+}
+
+// selectableAt returns the row the cursor stops on for row i: i itself, or the start of its entry
+func selectableAt(slots []slot, i int) int {
+	for j := i; j >= 0; j-- {
+		if slots[j].selectable() {
+			return j
+		}
+	}
+	return max(i, 0)
+}
+
+// slots splits the displayed hours of the day into half hours, with a row for every entry in each
+func (m Model) slots() []slot {
+	dayEnd := m.date.AddDate(0, 0, 1)
+	startHour, endHour := DefaultStartHour, DefaultEndHour
+
+	// Stretch the timeline to fit every entry, and now when it's today
+	stretch := func(start, end time.Time) {
+		if end.After(m.date) && start.Before(dayEnd) {
+			if start.Before(m.date) {
+				start = m.date
+			}
+			if end.After(dayEnd) {
+				end = dayEnd
+			}
+			startHour = min(startHour, int(start.Sub(m.date).Hours()))
+			endHour = max(endHour, int((end.Sub(m.date) + time.Hour - time.Nanosecond).Hours()))
+		}
+	}
+	for _, entry := range m.entries {
+		stretch(entry.TimeInterval.Start, entry.TimeInterval.Start.Add(entry.Duration()))
+	}
+	now := m.now()
+	stretch(now, now)
+
+	// Stack entries sharing a half hour in the order they started
+	entries := slices.Clone(m.entries)
+	slices.SortStableFunc(entries, func(a, b models.Entry) int {
+		return a.TimeInterval.Start.Compare(b.TimeInterval.Start)
+	})
+
+	var slots []slot
+	seen := map[string]bool{}
+	for t := m.date.Add(time.Duration(startHour) * time.Hour); t.Before(m.date.Add(time.Duration(endHour) * time.Hour)); t = t.Add(SlotLength) {
+		span := utils.Span{Start: t, End: t.Add(SlotLength)}
+		rows := 0
+		for i, entry := range entries {
+			if !entryIn(entry, span) {
+				continue
+			}
+			slots = append(slots, slot{
+				start:   span.Start,
+				end:     span.End,
+				entry:   &entries[i],
+				first:   !seen[entry.ID],
+				stacked: rows > 0,
+			})
+			seen[entry.ID] = true
+			rows++
+		}
+		if rows == 0 {
+			slots = append(slots, slot{start: span.Start, end: span.End})
+		}
+	}
+	return slots
+}
+
+// entryIn reports whether any of the entry falls in span, counting entries that start and end at once
+func entryIn(entry models.Entry, span utils.Span) bool {
+	start := entry.TimeInterval.Start
+	if start.IsZero() || !start.Before(span.End) {
+		return false
+	}
+	return start.Add(entry.Duration()).After(span.Start) || !start.Before(span.Start)
+}
+
+func (m Model) View() tea.View {
+	var sb strings.Builder
+
+	// Header with the date and the time logged on it
+	var total time.Duration
+	for _, entry := range m.entries {
+		total += entry.DurationOn(m.date)
+	}
+	sb.WriteString(headerStyle.Render(m.date.Format("Monday, January 2, 2006")))
+	sb.WriteString(styles.MutedTextStyle.Render(fmt.Sprintf("  ·  %s logged", formatDuration(total))) + "\n\n")
+
+	if !m.ready {
+		sb.WriteString(styles.MutedTextStyle.Render("Loading entries..."))
+		return tea.NewView(DayStyle.Render(sb.String()))
+	}
+
+	slots := m.slots()
+	first, last := m.visibleRange(len(slots))
+	if first > 0 {
+		sb.WriteString(styles.MutedTextStyle.Render(fmt.Sprintf("        ↑ %d more", first)) + "\n")
+	}
+	for i := first; i < last; i++ {
+		sb.WriteString(m.renderSlot(slots[i], i == m.cursor) + "\n")
+	}
+	if last < len(slots) {
+		sb.WriteString(styles.MutedTextStyle.Render(fmt.Sprintf("        ↓ %d more", len(slots)-last)) + "\n")
+	}
+
+	sb.WriteString("\n" + styles.HelpStyle.Render("h/l day · t today · j/k move · e edit · c copy · d delete · enter on free time to log it"))
+
+	return tea.NewView(DayStyle.Render(sb.String()))
+}
+
+// visibleRange returns the rows that fit the height, keeping the cursor in view
+func (m Model) visibleRange(count int) (int, int) {
+	// Room for the header, help, padding and the app's nav and info bars
+	rows := m.height - 14
+	if rows <= 0 || rows >= count {
+		return 0, count
+	}
+
+	first := max(0, min(m.cursor-rows/2, count-rows))
+	return first, first + rows
+}
+
+func (m Model) renderSlot(s slot, selected bool) string {
+	cursor := "  "
+	if selected {
+		cursor = styles.SelectedItemStyle.UnsetPadding().Render("❯ ")
+	}
+
+	label := "     "
+	if s.start.Minute() == 0 && !s.stacked {
+		label = s.start.Format("15:04")
+	}
+
+	blockWidth := max(m.width-lipgloss.Width(DayStyle.Render(""))-30, 20)
+
+	var block string
+	if s.entry == nil {
+		block = gapStyle.Render("┆")
+	} else {
+		text := ""
+		if s.first {
+			text = m.describe(*s.entry)
+		}
+		block = blockStyle.
+			Background(m.projectColor(s.entry.ProjectID)).
+			Width(blockWidth).
+			MaxHeight(1).
+			Render(text)
+	}
+
+	row := cursor + hourStyle.Render(label) + " │ " + block
+
+	// Mark the current time on today's timeline
+	now := m.now()
+	if !s.stacked && !now.Before(s.start) && now.Before(s.end) {
+		row += nowStyle.Render(" ◀ now " + now.Format("15:04"))
+	}
+	return row
+}
+
+// describe labels an entry's first row with its description, project and times
+func (m Model) describe(entry models.Entry) string {
+	description := entry.Description
+	if description == "" {
+		description = "(No Description)"
+	}
+	if project, err := utils.FindProjectById(m.projects, entry.ProjectID); err == nil {
+		description += " · " + project.Name
+	}
+
+	end := "running"
+	if !entry.IsRunning() {
+		end = entry.TimeInterval.End.In(time.Local).Format("15:04")
+	}
+	return fmt.Sprintf("%s  %s–%s", description, entry.TimeInterval.Start.In(time.Local).Format("15:04"), end)
+}
+
+// projectColor returns the project's Clockify colour, muted for entries without a project
+func (m Model) projectColor(projectID string) color.Color {
+	project, err := utils.FindProjectById(m.projects, projectID)
+	if err != nil || project.Color == "" {
+		return styles.Muted
+	}
+	return lipgloss.Color(project.Color)
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func formatDuration(d time.Duration) string {
+	h := int(d.Hours())
+	min := int(d.Minutes()) % 60
+	if h == 0 {
+		return fmt.Sprintf("%dm", min)
+	}
+	return fmt.Sprintf("%dh %dm", h, min)
+}
@@ -0,0 +1,203 @@
+package day
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func at(hour, min int) time.Time {
+	return time.Date(2026, 10, 12, hour, min, 0, 0, time.Local)
+}
+
+func entryAt(id string, start, end time.Time) models.Entry {
+	return models.Entry{
+		ID:          id,
+		Description: "Work " + id,
+		TimeInterval: models.IntervalTime{
+			Start: start,
+			End:   end,
+		},
+	}
+}
+
+// loaded returns a day view of Monday, October 12 at 12:10 with the given entries
+func loaded(entries ...models.Entry) Model {
+	m := New(&config.Config{})
+	m.now = func() time.Time { return at(12, 10) }
+	m.date = startOfDay(m.now())
+
+	start, end := api.DayRange(m.date)
+	m, _ = m.Update(messages.EntriesLoadedMsg{Entries: entries, Start: start, End: end})
+	return m
+}
+
+func press(m Model, key rune) (Model, tea.Cmd) {
+	return m.Update(tea.KeyPressMsg{Code: key, Text: string(key)})
+}
+
+// action returns the message a key sends for the selected slot
+func action(m Model, key rune) tea.Msg {
+	if key == tea.KeyEnter {
+		_, cmd := m.Update(tea.KeyPressMsg{Code: key})
+		return cmd()
+	}
+	_, cmd := press(m, key)
+	if cmd == nil {
+		return nil
+	}
+	return cmd()
+}
+
+func TestSlots(t *testing.T) {
+	m := loaded(
+		entryAt("a", at(9, 0), at(10, 0)),
+		entryAt("b", at(19, 0), at(19, 20)),
+	)
+
+	slots := m.slots()
+	if !slots[0].start.Equal(at(8, 0)) {
+		t.Errorf("Expected the timeline to start at 08:00, got %v", slots[0].start)
+	}
+	if last := slots[len(slots)-1]; !last.end.Equal(at(20, 0)) {
+		t.Errorf("Expected the timeline to stretch to 20:00 for the late entry, got %v", last.end)
+	}
+
+	// 09:00 and 09:30 belong to a, only the first carries its label
+	if slots[2].entry == nil || slots[2].entry.ID != "a" || !slots[2].first {
+		t.Errorf("Expected 09:00 to start entry a, got %+v", slots[2])
+	}
+	if slots[3].entry == nil || slots[3].first {
+		t.Errorf("Expected 09:30 to continue entry a, got %+v", slots[3])
+	}
+	if slots[4].entry != nil {
+		t.Errorf("Expected 10:00 to be free, got %+v", slots[4])
+	}
+}
+
+func TestShortAndConcurrentEntries(t *testing.T) {
+	m := loaded(
+		entryAt("long", at(9, 0), at(10, 0)),
+		entryAt("short", at(9, 10), at(9, 20)),
+		entryAt("brief", at(10, 40), at(10, 45)),
+	)
+	m.cursor = 2 // 09:00, entry long
+
+	// Each entry gets a labelled row, even when another fills most of its half hour
+	view := m.View().Content
+	for _, description := range []string{"Work long", "Work short", "Work brief"} {
+		if !strings.Contains(view, description) {
+			t.Errorf("Expected %q on the timeline", description)
+		}
+	}
+
+	// The cursor moves between entries, skipping the rows that continue one
+	var visited []string
+	for range 4 {
+		m, _ = press(m, 'j')
+		s := m.slots()[m.cursor]
+		if s.entry == nil {
+			visited = append(visited, s.start.Format("15:04"))
+		} else {
+			visited = append(visited, s.entry.ID)
+		}
+	}
+	if want := []string{"short", "10:00", "brief", "11:00"}; !slices.Equal(visited, want) {
+		t.Errorf("Expected the cursor to visit %v, got %v", want, visited)
+	}
+
+	for range 3 {
+		m, _ = press(m, 'k')
+	}
+	msg := action(m, 'e')
+	if update, ok := msg.(messages.EntryUpdateStartedMsg); !ok || update.Entry.ID != "short" {
+		t.Errorf("Expected to edit the short entry, got %#v", msg)
+	}
+}
+
+func TestCursorStartsAtNow(t *testing.T) {
+	m := loaded(entryAt("a", at(9, 0), at(10, 0)))
+
+	if s := m.slots()[m.cursor]; !s.start.Equal(at(12, 0)) {
+		t.Errorf("Expected the cursor on the 12:00 slot, got %v", s.start)
+	}
+	if !strings.Contains(m.View().Content, "◀ now 12:10") {
+		t.Error("Expected the current time to be marked")
+	}
+}
+
+func TestPaging(t *testing.T) {
+	m := loaded()
+
+	m, _ = press(m, 'h')
+	if !m.date.Equal(at(0, 0).AddDate(0, 0, -1)) {
+		t.Errorf("Expected the previous day, got %v", m.date)
+	}
+	if m.ready {
+		t.Error("Expected the day to reload after paging")
+	}
+
+	// Responses for the day we left are dropped
+	start, end := api.DayRange(at(0, 0))
+	m, _ = m.Update(messages.EntriesLoadedMsg{Entries: []models.Entry{entryAt("a", at(9, 0), at(10, 0))}, Start: start, End: end})
+	if m.ready || len(m.entries) != 0 {
+		t.Error("Expected the stale response to be ignored")
+	}
+
+	m, _ = press(m, 'l')
+	m, _ = press(m, 'l')
+	if !m.date.Equal(at(0, 0).AddDate(0, 0, 1)) {
+		t.Errorf("Expected the next day, got %v", m.date)
+	}
+
+	m, _ = press(m, 't')
+	if !m.date.Equal(at(0, 0)) {
+		t.Errorf("Expected today, got %v", m.date)
+	}
+}
+
+func TestSlotActions(t *testing.T) {
+	m := loaded(entryAt("a", at(9, 0), at(10, 0)))
+	m.cursor = 2 // 09:00
+
+	msg := action(m, 'e')
+	if update, ok := msg.(messages.EntryUpdateStartedMsg); !ok || update.Entry.ID != "a" {
+		t.Errorf("Expected an update of entry a, got %#v", msg)
+	}
+	msg = action(m, 'c')
+	if copy, ok := msg.(messages.EntryCopyStartedMsg); !ok || copy.Entry.ID != "a" {
+		t.Errorf("Expected a copy of entry a, got %#v", msg)
+	}
+	msg = action(m, 'd')
+	if del, ok := msg.(messages.EntryDeleteStartedMsg); !ok || del.EntryId != "a" {
+		t.Errorf("Expected a delete of entry a, got %#v", msg)
+	}
+
+	// Free time opens a new entry covering the slot
+	m.cursor = 4 // 10:00
+	msg = action(m, tea.KeyEnter)
+	create, ok := msg.(messages.EntryCreateStartedMsg)
+	if !ok || !create.Start.Equal(at(10, 0)) || !create.End.Equal(at(10, 30)) {
+		t.Errorf("Expected a new entry for 10:00-10:30, got %#v", msg)
+	}
+	if msg = action(m, 'd'); msg != nil {
+		t.Errorf("Expected nothing to delete on free time, got %#v", msg)
+	}
+}
+
+func TestInitCanBeCancelled(t *testing.T) {
+	m := New(&config.Config{})
+	if cmd := m.Init(); cmd == nil {
+		t.Fatal("Expected Init to fetch the day")
+	}
+	if m.cancelFetch == nil {
+		t.Error("Expected Init to keep the fetch's cancel func, so paging can cancel it")
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"clockify-app/internal/models"
 	"clockify-app/internal/styles"
 	"clockify-app/internal/utils"
+	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/list"
@@ -19,6 +21,7 @@ import (
 type Model struct {
 	config   *config.Config
 	projects []models.Project
+	tags     []models.Tag
 	entries  []models.Entry
 
 	list list.Model
@@ -42,10 +45,25 @@ func New(cfg *config.Config) Model {
 }
 
 func (m Model) Init() tea.Cmd {
-	return api.FetchEntries(
-		m.config.APIKey,
-		m.config.WorkspaceId,
-		m.config.UserId,
+	return tea.Batch(
+		api.FetchTags(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
+		api.FetchEntries(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+			m.config.UserId,
+		),
+		api.RevalidateTags(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
+		api.RevalidateEntries(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+			m.config.UserId,
+		),
 	)
 }
 
@@ -61,11 +79,14 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case tea.KeyPressMsg:
+		// Keys typed into the filter are search text, not actions
+		if m.Editing() {
+			break
+		}
 		switch msg.String() {
 		case "e":
 			// Edit the selected entry
-			if len(m.entries) > 0 {
-				selectedEntry := m.entries[m.list.Index()]
+			if selectedEntry, ok := m.selectedEntry(); ok {
 				// Open the edit modal (not implemented here)
 				return m, func() tea.Msg {
 					return messages.EntryUpdateStartedMsg{Entry: selectedEntry}
@@ -73,17 +94,27 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 		case "d":
 			// Delete the selected entry
-			if len(m.entries) > 0 {
-				selectedEntry := m.entries[m.list.Index()]
+			if selectedEntry, ok := m.selectedEntry(); ok {
 				// Open the delete confirmation modal (not implemented here)
 				return m, func() tea.Msg {
 					return messages.EntryDeleteStartedMsg{EntryId: selectedEntry.ID}
 				}
 			}
+		case "s":
+			// Start a new timer from the selected entry
+			if selectedEntry, ok := m.selectedEntry(); ok {
+				return m, api.StartTimer(
+					m.config.APIKey,
+					m.config.WorkspaceId,
+					selectedEntry.ProjectID,
+					selectedEntry.TaskID,
+					selectedEntry.Description,
+					selectedEntry.TagIDs,
+				)
+			}
 		case "c":
 			// Copy the selected entry
-			if len(m.entries) > 0 {
-				selectedEntry := m.entries[m.list.Index()]
+			if selectedEntry, ok := m.selectedEntry(); ok {
 				// Open the edit modal
 				return m, func() tea.Msg {
 					return messages.EntryCopyStartedMsg{Entry: selectedEntry}
@@ -92,38 +123,20 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 	case messages.EntriesLoadedMsg:
+		// Ranged results belong to the week, month or reports views
+		if !msg.Start.IsZero() {
+			return m, nil
+		}
 		m.entries = msg.Entries
-		items := make([]list.Item, len(m.entries))
-		for i, entry := range m.entries {
-			// Get the description or a placeholder
-			description := entry.Description
-			if description == "" {
-				description = "(No Description)"
-			}
-			// Get the project name or a default
-			projectName := "No Project"
-			project, _ := utils.FindProjectById(m.projects, entry.ProjectID)
-			if project.ID != "" && project.ClientName != "" {
-				projectName = fmt.Sprintf("%s - %s", project.Name, project.ClientName)
-			}
-			if project.ID != "" {
-				projectName = fmt.Sprintf("%s", project.Name)
-			}
+		m.setItems()
 
-			if projectName != "" {
-				description = fmt.Sprintf("%s %s", description, styles.MutedTextStyle.Render("("+projectName+")"))
-			}
-			items[i] = item{
-				title: description,
-				date:  entry.TimeInterval.Start.In(time.Local),
-				desc: fmt.Sprintf(
-					"%s-%s",
-					entry.TimeInterval.Start.In(time.Local).Format("Mon, 2006_01_02 03:04PM"),
-					entry.TimeInterval.End.In(time.Local).Format("03:04PM"),
-				),
-			}
-		}
-		m.list.SetItems(items)
+	case messages.TagsLoadedMsg:
+		m.tags = msg.Tags
+		m.setItems()
+
+	case messages.TagCreatedMsg:
+		m.tags = append(m.tags, msg.Tag)
+		m.setItems()
 
 	case messages.ProjectsLoadedMsg:
 		m.projects = msg.Projects
@@ -131,23 +144,24 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case messages.ItemDeletedMsg:
 		if msg.Type == "entry" {
 			c := api.NewClient(m.config.APIKey)
-			err := c.DeleteTimeEntry(m.config.WorkspaceId, msg.ID)
+			err := c.DeleteTimeEntry(context.Background(), m.config.WorkspaceId, msg.ID)
 			if err != nil {
 				return m, func() tea.Msg {
 					return messages.ErrorMsg{Err: err}
 				}
 			}
-			return m, api.FetchEntries(
-				m.config.APIKey,
-				m.config.WorkspaceId,
-				m.config.UserId,
+			return m, tea.Batch(
+				func() tea.Msg {
+					return messages.NotifyMsg{Level: messages.NotifySuccess, Text: "Entry deleted"}
+				},
+				api.FetchEntries(
+					m.config.APIKey,
+					m.config.WorkspaceId,
+					m.config.UserId,
+				),
 			)
 		}
 		return m, nil
-
-	case messages.ErrorMsg:
-		// Handle error (could set an error field in the model)
-		lipgloss.Printf("Error: %v\n", msg.Err)
 	}
 
 	m.list, cmd = m.list.Update(msg)
@@ -156,17 +170,84 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// Editing reports whether the list filter is capturing keys
+func (m Model) Editing() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
+// selectedEntry returns the entry under the cursor, which with a filter applied
+// isn't the one at the same position in m.entries
+func (m Model) selectedEntry() (models.Entry, bool) {
+	selected, ok := m.list.SelectedItem().(item)
+	if !ok {
+		return models.Entry{}, false
+	}
+	return selected.entry, true
+}
+
+// setItems rebuilds the list from the loaded entries, projects and tags
+func (m *Model) setItems() {
+	items := make([]list.Item, len(m.entries))
+	for i, entry := range m.entries {
+		// Get the description or a placeholder
+		description := entry.Description
+		if description == "" {
+			description = "(No Description)"
+		}
+		// Get the project name or a default
+		projectName := "No Project"
+		project, _ := utils.FindProjectById(m.projects, entry.ProjectID)
+		if project.ID != "" && project.ClientName != "" {
+			projectName = fmt.Sprintf("%s - %s", project.Name, project.ClientName)
+		}
+		if project.ID != "" {
+			projectName = fmt.Sprintf("%s", project.Name)
+		}
+
+		if projectName != "" {
+			description = fmt.Sprintf("%s %s", description, styles.MutedTextStyle.Render("("+projectName+")"))
+		}
+		end := styles.TimerStyle.UnsetPadding().Render("running")
+		if !entry.IsRunning() {
+			end = entry.TimeInterval.End.In(time.Local).Format("03:04PM")
+			// Entries that cross midnight show the day they end on
+			if len(entry.Days(time.Local)) > 1 {
+				end = entry.TimeInterval.End.In(time.Local).Format("Mon 03:04PM")
+			}
+		}
+		desc := fmt.Sprintf(
+			"%s-%s",
+			entry.TimeInterval.Start.In(time.Local).Format("Mon, 2006_01_02 03:04PM"),
+			end,
+		)
+		tags := strings.Join(utils.TagNames(m.tags, entry.TagIDs), ", ")
+		if tags != "" {
+			desc = fmt.Sprintf("%s  🏷 %s", desc, tags)
+		}
+		items[i] = item{
+			entry: entry,
+			title: description,
+			date:  entry.TimeInterval.Start.In(time.Local),
+			desc:  desc,
+			tags:  tags,
+		}
+	}
+	m.list.SetItems(items)
+}
+
 var docStyle = lipgloss.NewStyle()
 
 type item struct {
+	entry models.Entry
 	title string
 	desc  string
+	tags  string
 	date  time.Time
 }
 
 func (i item) Title() string       { return i.title }
 func (i item) Description() string { return i.desc }
-func (i item) FilterValue() string { return i.title }
+func (i item) FilterValue() string { return i.title + " " + i.tags }
 
 var dayHeaderStyle = lipgloss.NewStyle().
 	Bold(true).
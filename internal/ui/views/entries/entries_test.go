@@ -0,0 +1,54 @@
+package entries
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func loaded(entries ...models.Entry) Model {
+	m := New(&config.Config{})
+	m.SetSize(80, 40)
+	m, _ = m.Update(messages.EntriesLoadedMsg{Entries: entries})
+	return m
+}
+
+func entry(id, description string, hour int) models.Entry {
+	start := time.Date(2026, 10, 14, hour, 0, 0, 0, time.Local)
+	return models.Entry{
+		ID:           id,
+		Description:  description,
+		TimeInterval: models.IntervalTime{Start: start, End: start.Add(time.Hour)},
+	}
+}
+
+func TestFilterTakesKeys(t *testing.T) {
+	m := loaded(entry("a", "Alpha", 9), entry("b", "Beta", 10))
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !m.Editing() {
+		t.Fatal("Expected / to start filtering")
+	}
+
+	// s is search text while filtering, not a timer start
+	m, _ = m.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	if m.list.FilterValue() != "s" {
+		t.Errorf("Expected s in the filter, got %q", m.list.FilterValue())
+	}
+}
+
+func TestSelectedEntryFollowsFilter(t *testing.T) {
+	m := loaded(entry("a", "Alpha", 9), entry("b", "Beta", 10))
+
+	m.list.SetFilterText("Beta")
+	if selected, ok := m.selectedEntry(); !ok || selected.ID != "b" {
+		t.Errorf("Expected the filtered entry, got %+v", selected)
+	}
+	if m.Editing() {
+		t.Error("An applied filter no longer captures keys")
+	}
+}
@@ -2,11 +2,18 @@ package month
 
 import (
 	"clockify-app/internal/api"
+	"clockify-app/internal/balance"
 	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
 	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
+	"context"
 	"fmt"
+	"image/color"
+	"slices"
+	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -18,10 +25,29 @@ var TableStyle = lipgloss.NewStyle().Padding(0, 2)
 
 var ColumnWidth = 10
 
+// Heat shades a day by its share of its target, in quarters up to the full target
+var Heat = []color.Color{
+	lipgloss.Color("#2B3A26"),
+	lipgloss.Color("#3E5A30"),
+	lipgloss.Color("#5C853F"),
+	lipgloss.Color("#7FAE55"),
+	styles.Primary,
+}
+
 type Model struct {
 	config       *config.Config
 	entries      []models.Entry
+	projects     []models.Project
 	currentMonth time.Time
+	daysOff      holidays.Calendar  // Holidays and leave in the displayed month
+	selected     time.Time          // Midnight of the selected day, always inside currentMonth
+	entryCursor  int                // Selected entry in the day's list
+	listFocused  bool               // Whether j/k move through the day's entries instead of the calendar
+	cancelFetch  context.CancelFunc // Cancels the in-flight fetch when paging
+	ledger       *balance.Ledger    // Overtime balance up to today, nil until loaded
+	ledgerStale  bool               // Whether entries changed since the balance was fetched
+	cancelLedger context.CancelFunc // Cancels the in-flight balance fetch when refreshing
+	now          func() time.Time
 
 	table *table.Table
 
@@ -38,8 +64,20 @@ var (
 
 	cellStyle = lipgloss.NewStyle().
 			Padding(0, 1).
-			Width(14).
+			Width(ColumnWidth + 2).
 			Align(lipgloss.Center)
+
+	dayOffStyle = cellStyle.Foreground(styles.Muted)
+
+	// Holidays and leave, set apart from weekends
+	holidayStyle = cellStyle.Foreground(styles.Tertiary).Italic(true)
+
+	selectedCellStyle = cellStyle.
+				Background(styles.Secondary).
+				Foreground(styles.Background).
+				Bold(true)
+
+	listTitleStyle = lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
 )
 
 func New(cfg *config.Config) Model {
@@ -47,28 +85,82 @@ func New(cfg *config.Config) Model {
 		config:       cfg,
 		entries:      []models.Entry{},
 		currentMonth: time.Now(),
+		now:          time.Now,
 		ready:        false,
 	}
+	m.selected = startOfDay(m.now())
 
 	m.table = table.New().
 		Border(lipgloss.NormalBorder()).
 		BorderStyle(lipgloss.NewStyle().Foreground(styles.Secondary)).
-		BorderRow(true).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			if row == table.HeaderRow {
-				return headerStyle
-			}
-			return cellStyle
-		})
+		BorderRow(true)
 
 	return m
 }
 
-func (m Model) Init() tea.Cmd {
-	return api.FetchEntriesForMonth(m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.currentMonth)
+// Init fetches the displayed month, and the balance unless the loaded one is still current
+func (m *Model) Init() tea.Cmd {
+	if m.balanceCurrent() {
+		return m.fetchMonth()
+	}
+	return tea.Batch(m.fetchMonth(), m.fetchBalance())
+}
+
+// Refresh fetches the displayed month and the balance again, after an entry was saved or deleted
+func (m *Model) Refresh() tea.Cmd {
+	return tea.Batch(m.fetchMonth(), m.fetchBalance())
+}
+
+// InvalidateBalance has the balance fetched again the next time the month is shown,
+// after entries changed while another view was showing
+func (m *Model) InvalidateBalance() {
+	m.ledgerStale = true
+}
+
+// balanceCurrent reports whether the loaded balance still runs up to today with nothing changed since
+func (m Model) balanceCurrent() bool {
+	return m.ledger != nil && !m.ledgerStale && m.ledger.End.Equal(startOfDay(m.now()).AddDate(0, 0, 1))
+}
+
+// fetchBalance fetches the overtime balance, cancelling any fetch still in flight
+func (m *Model) fetchBalance() tea.Cmd {
+	if m.cancelLedger != nil {
+		m.cancelLedger()
+	}
+	m.ledgerStale = false
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelLedger = cancel
+
+	return api.FetchBalance(ctx, m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.config.Schedule, m.now())
+}
+
+// fetchMonth fetches the displayed month, cancelling any fetch still in flight
+func (m *Model) fetchMonth() tea.Cmd {
+	if m.cancelFetch != nil {
+		m.cancelFetch()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelFetch = cancel
+
+	return m.fetch(ctx)
+}
+
+// fetch loads the month's entries and its days off
+func (m Model) fetch(ctx context.Context) tea.Cmd {
+	start, end := api.MonthRange(m.currentMonth)
+	return tea.Batch(
+		api.FetchEntriesForMonth(ctx, m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.currentMonth),
+		api.FetchDaysOff(ctx, m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.config.Schedule, start, end),
+	)
 }
 
 func (m Model) View() tea.View {
+	weeks, totals := m.weeks(), m.dailyTotals()
+	m.table.StyleFunc(func(row, col int) lipgloss.Style {
+		return m.cellStyle(weeks, totals, row, col)
+	})
 
 	footer := m.renderFooter()
 
@@ -83,25 +175,124 @@ func (m Model) View() tea.View {
 				lipgloss.Left,
 				m.table.Render(),
 				footer,
+				m.renderDaysOff(),
+				"",
+				m.renderDay(),
+				"",
+				styles.HelpStyle.Render("h/j/k/l select day · [/] month · t today · enter entries · e edit · c copy · d delete"),
 			),
 		),
 	))
 }
 
-func (m Model) renderFooter() string {
-	monthTotal := m.calculateMonthTotal()
+// cellStyle shades each day by its hours, highlighting the selected day
+func (m Model) cellStyle(weeks [][7]time.Time, totals map[string]time.Duration, row, col int) lipgloss.Style {
+	if row == table.HeaderRow {
+		return headerStyle
+	}
+
+	if row >= len(weeks) || col >= 7 {
+		return cellStyle
+	}
+	day := weeks[row][col]
+	switch {
+	case day.IsZero():
+		return cellStyle
+	case day.Equal(m.selected):
+		return selectedCellStyle
+	}
+
+	schedule := m.config.Schedule
+	style := cellStyle
+	if !schedule.IsWorkingDay(day.Weekday()) {
+		style = dayOffStyle
+	}
+	if _, ok := m.daysOff.On(day); ok {
+		style = holidayStyle
+	}
+
+	// Time on a day off is weighed against a full working day
+	target := m.daysOff.Target(schedule, day)
+	if target == 0 {
+		target = schedule.LongestDay()
+	}
+	if shade, ok := heat(totals[day.Format("2006-01-02")], target); ok {
+		style = style.Background(shade).Foreground(styles.Text)
+		if shade == Heat[len(Heat)-1] {
+			style = style.Foreground(styles.Background)
+		}
+	}
+	return style
+}
 
-	monthStart := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), 1, 0, 0, 0, 0, time.UTC)
-	monthEnd := time.Date(m.currentMonth.Year(), m.currentMonth.Month()+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+// heat returns the shade for a day's hours against its target, false for days without time
+func heat(d, target time.Duration) (color.Color, bool) {
+	if d <= 0 {
+		return nil, false
+	}
+	if target <= 0 {
+		return Heat[len(Heat)-1], true
+	}
+	level := int(float64(d) / float64(target) * float64(len(Heat)-1))
+	return Heat[min(level, len(Heat)-1)], true
+}
 
-	workingDays := 0
-	for d := monthStart; d.Before(monthEnd); d = d.AddDate(0, 0, 1) {
-		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
-			workingDays++
+// renderDay lists the selected day's entries
+func (m Model) renderDay() string {
+	var sb strings.Builder
+	sb.WriteString(listTitleStyle.Render(m.selected.Format("Monday, January 2")))
+	if off, ok := m.daysOff.On(m.selected); ok {
+		sb.WriteString(styles.MutedTextStyle.Render(" · " + dayOffName(off)))
+	}
+	sb.WriteString("\n")
+
+	entries := m.dayEntries()
+	if !m.ready {
+		sb.WriteString(styles.MutedTextStyle.Render("Loading entries..."))
+		return sb.String()
+	}
+	if len(entries) == 0 {
+		sb.WriteString(styles.MutedTextStyle.Render("No time logged"))
+		return sb.String()
+	}
+
+	for i, entry := range entries {
+		cursor := "  "
+		if m.listFocused && i == m.entryCursor {
+			cursor = styles.SelectedItemStyle.UnsetPadding().Render("❯ ")
+		}
+
+		end := "running"
+		if !entry.IsRunning() {
+			end = entry.TimeInterval.End.In(time.Local).Format("15:04")
+		}
+		description := entry.Description
+		if description == "" {
+			description = "(No Description)"
 		}
+		if project, err := utils.FindProjectById(m.projects, entry.ProjectID); err == nil {
+			description += " · " + project.Name
+		}
+
+		line := fmt.Sprintf("%s–%-7s  %8s  %s",
+			entry.TimeInterval.Start.In(time.Local).Format("15:04"),
+			end,
+			formatDuration(entry.DurationOn(m.selected)),
+			description,
+		)
+		if m.listFocused && i == m.entryCursor {
+			line = styles.SelectedItemStyle.UnsetPadding().Render(line)
+		}
+		sb.WriteString(cursor + line + "\n")
 	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (m Model) renderFooter() string {
+	monthTotal := m.calculateMonthTotal()
 
-	maxMonth := workingDays * 8
+	monthStart := m.firstOfMonth()
+	maxMonth := m.daysOff.TargetBetween(m.config.Schedule, monthStart, monthStart.AddDate(0, 1, 0))
 
 	totalStyle := lipgloss.NewStyle()
 
@@ -113,44 +304,148 @@ func (m Model) renderFooter() string {
 
 	value := lipgloss.NewStyle().
 		Foreground(styles.Text).
-		Render(fmt.Sprintf("%s / %dh", formatDuration(monthTotal), maxMonth))
+		Render(fmt.Sprintf("%s / %s", formatDuration(monthTotal), formatTarget(maxMonth)))
+
+	billable := m.calculateBillableTotal()
+	split := styles.MutedTextStyle.Render(fmt.Sprintf(
+		"Billable %s · Non-billable %s",
+		formatDuration(billable),
+		formatDuration(monthTotal-billable),
+	))
 
 	content := lipgloss.JoinHorizontal(
 		lipgloss.Left,
 		label,
 		"  ",
 		value,
+		"    ",
+		split,
 	)
+	if m.ledger != nil {
+		content = lipgloss.JoinVertical(lipgloss.Left, content, m.renderBalance())
+	}
 
 	return totalStyle.Render(content)
 }
 
+// renderBalance shows the overtime balance, in the warning color when behind
+func (m Model) renderBalance() string {
+	label := lipgloss.NewStyle().
+		Foreground(styles.Secondary).
+		Padding(0, 1).
+		Bold(true).
+		Render("Balance    ")
+
+	color := styles.Primary
+	if m.ledger.Balance() < 0 {
+		color = styles.Warning
+	}
+	value := lipgloss.NewStyle().
+		Foreground(color).
+		Render(balance.Format(m.ledger.Balance()))
+
+	since := styles.MutedTextStyle.Render(fmt.Sprintf(
+		"since %s · %s of %s",
+		m.ledger.Start.Format("Jan 2, 2006"),
+		formatDuration(m.ledger.Actual),
+		formatTarget(m.ledger.Target),
+	))
+
+	return lipgloss.JoinHorizontal(lipgloss.Left, label, "  ", value, "    ", since)
+}
+
+// renderDaysOff names the month's holidays and leave, which the calendar marks
+func (m Model) renderDaysOff() string {
+	monthStart := m.firstOfMonth()
+	var names []string
+	for _, off := range m.daysOff.Between(monthStart, monthStart.AddDate(0, 1, 0)) {
+		names = append(names, off.Date.Format("01/02")+" "+dayOffName(off))
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(styles.Tertiary).Padding(0, 1).Render("Days off: " + strings.Join(names, " · "))
+}
+
+func dayOffName(off models.DayOff) string {
+	if off.Half {
+		return off.Name + " (half day)"
+	}
+	return off.Name
+}
+
 func (m Model) calculateMonthTotal() time.Duration {
 	var total time.Duration
 	for _, entry := range m.entries {
-		if entry.TimeInterval.End.IsZero() {
-			continue
+		// Running entries count up to now
+		total += m.durationInMonth(entry)
+	}
+	return total
+}
+
+// calculateBillableTotal sums the billable part of the month total
+func (m Model) calculateBillableTotal() time.Duration {
+	var total time.Duration
+	for _, entry := range m.entries {
+		if entry.Billable {
+			total += m.durationInMonth(entry)
+		}
+	}
+	return total
+}
+
+// durationInMonth leaves out the part of an overnight entry that spills into the next month
+func (m Model) durationInMonth(entry models.Entry) time.Duration {
+	var total time.Duration
+	for _, day := range entry.Days(time.Local) {
+		if day.Year() == m.currentMonth.Year() && day.Month() == m.currentMonth.Month() {
+			total += entry.DurationOn(day)
 		}
-		total += entry.TimeInterval.End.Sub(entry.TimeInterval.Start)
 	}
 	return total
 }
 
 func (m Model) NextMonth() (Model, tea.Cmd) {
-	m.currentMonth = m.currentMonth.AddDate(0, 1, 0)
-	m.ready = false
-	m.entries = []models.Entry{}
-	m.table.ClearRows()
-	return m, api.FetchEntriesForMonth(m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.currentMonth)
+	return m.showMonth(m.firstOfMonth().AddDate(0, 1, 0))
 }
 
 func (m Model) PreviousMonth() (Model, tea.Cmd) {
-	m.currentMonth = m.currentMonth.AddDate(0, -1, 0)
+	return m.showMonth(m.firstOfMonth().AddDate(0, -1, 0))
+}
+
+// showMonth pages to the month starting at first, selecting the same day of the month or its last day
+func (m Model) showMonth(first time.Time) (Model, tea.Cmd) {
+	last := first.AddDate(0, 1, -1)
+	m.currentMonth = first
+	m.selected = first.AddDate(0, 0, min(m.selected.Day(), last.Day())-1)
+	return m.reload()
+}
+
+func (m Model) firstOfMonth() time.Time {
+	return time.Date(m.currentMonth.Year(), m.currentMonth.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+// selectDay moves the selection, paging when it leaves the displayed month
+func (m Model) selectDay(day time.Time) (Model, tea.Cmd) {
+	m.entryCursor = 0
+	if day.Year() == m.currentMonth.Year() && day.Month() == m.currentMonth.Month() {
+		m.selected = day
+		return m, nil
+	}
+
+	m.currentMonth = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.Local)
+	m.selected = day
+	return m.reload()
+}
+
+func (m Model) reload() (Model, tea.Cmd) {
 	m.ready = false
 	m.entries = []models.Entry{}
+	m.daysOff = nil
+	m.entryCursor = 0
+	m.listFocused = false
 	m.table.ClearRows()
-	return m, api.FetchEntriesForMonth(m.config.APIKey, m.config.WorkspaceId, m.config.UserId, m.currentMonth)
-
+	return m, m.fetchMonth()
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
@@ -158,27 +453,139 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmds []tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.listFocused {
+			cmds = append(cmds, m.updateList(msg))
+			break
+		}
+
 		switch msg.String() {
-		case "h", "left":
+		case "[", "H":
 			m, cmd = m.PreviousMonth()
 			cmds = append(cmds, cmd)
-		case "l", "right":
+		case "]", "L":
 			m, cmd = m.NextMonth()
 			cmds = append(cmds, cmd)
+		case "t":
+			m, cmd = m.selectDay(startOfDay(m.now()))
+			cmds = append(cmds, cmd)
+		case "h", "left":
+			m, cmd = m.selectDay(m.selected.AddDate(0, 0, -1))
+			cmds = append(cmds, cmd)
+		case "l", "right":
+			m, cmd = m.selectDay(m.selected.AddDate(0, 0, 1))
+			cmds = append(cmds, cmd)
+		case "k", "up":
+			m, cmd = m.selectDay(m.selected.AddDate(0, 0, -7))
+			cmds = append(cmds, cmd)
+		case "j", "down":
+			m, cmd = m.selectDay(m.selected.AddDate(0, 0, 7))
+			cmds = append(cmds, cmd)
+		case "enter", "tab":
+			if len(m.dayEntries()) > 0 {
+				m.listFocused = true
+			}
+		case "e", "c", "d":
+			cmds = append(cmds, m.entryAction(msg.String()))
 		}
 
 	case messages.EntriesLoadedMsg:
+		// Drop responses for months we've already paged away from
+		if !msg.ForRange(api.MonthRange(m.currentMonth)) {
+			break
+		}
 		m.entries = msg.Entries
 		m.table.ClearRows()
 		m.table.Headers(m.tableHeaders()...)
 		m.table.Rows(m.setTableData()...)
 		m.SetSize(m.width, m.height)
 		m.ready = true
+
+		// Keep the selection inside the day's list when entries come and go
+		m.entryCursor = max(0, min(m.entryCursor, len(m.dayEntries())-1))
+		if len(m.dayEntries()) == 0 {
+			m.listFocused = false
+		}
+
+	case messages.DaysOffLoadedMsg:
+		if !msg.ForRange(api.MonthRange(m.currentMonth)) {
+			break
+		}
+		m.daysOff = holidays.NewCalendar(msg.Days)
+		if m.ready {
+			m.table.ClearRows()
+			m.table.Rows(m.setTableData()...)
+		}
+
+	case messages.BalanceLoadedMsg:
+		m.ledger = &msg.Ledger
+
+	case messages.ProjectsLoadedMsg:
+		m.projects = msg.Projects
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
+// updateList moves through the selected day's entries
+func (m *Model) updateList(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "tab":
+		m.listFocused = false
+	case "k", "up":
+		if m.entryCursor > 0 {
+			m.entryCursor--
+		}
+	case "j", "down":
+		if m.entryCursor < len(m.dayEntries())-1 {
+			m.entryCursor++
+		}
+	case "e", "enter":
+		return m.entryAction("e")
+	case "c", "d":
+		return m.entryAction(msg.String())
+	}
+	return nil
+}
+
+// entryAction opens the edit, copy or delete flow for the day's selected entry
+func (m Model) entryAction(key string) tea.Cmd {
+	entries := m.dayEntries()
+	if m.entryCursor >= len(entries) {
+		return nil
+	}
+
+	entry := entries[m.entryCursor]
+	switch key {
+	case "e":
+		return func() tea.Msg {
+			return messages.EntryUpdateStartedMsg{Entry: entry}
+		}
+	case "c":
+		return func() tea.Msg {
+			return messages.EntryCopyStartedMsg{Entry: entry}
+		}
+	case "d":
+		return func() tea.Msg {
+			return messages.EntryDeleteStartedMsg{EntryId: entry.ID}
+		}
+	}
+	return nil
+}
+
+// dayEntries returns the entries on the selected day, in start order
+func (m Model) dayEntries() []models.Entry {
+	var entries []models.Entry
+	for _, entry := range m.entries {
+		if entry.DurationOn(m.selected) > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	slices.SortFunc(entries, func(a, b models.Entry) int {
+		return a.TimeInterval.Start.Compare(b.TimeInterval.Start)
+	})
+	return entries
+}
+
 func (m *Model) SetSize(width, height int) {
 	m.width = width
 	m.height = height
@@ -187,78 +594,95 @@ func (m *Model) SetSize(width, height int) {
 var WeekColumnWidth = 8
 var WeekLabelWidth = 8
 
+// dayNames labels the calendar's columns
+var dayNames = map[time.Weekday]string{
+	time.Monday:    "Mon",
+	time.Tuesday:   "Tues",
+	time.Wednesday: "Wed",
+	time.Thursday:  "Thurs",
+	time.Friday:    "Fri",
+	time.Saturday:  "Sat",
+	time.Sunday:    "Sun",
+}
+
 func (m Model) tableHeaders() []string {
-	return []string{"Mon", "Tues", "Wed", "Thurs", "Fri", "Total"}
+	var headers []string
+	for _, day := range m.config.Schedule.WeekDays() {
+		headers = append(headers, dayNames[day])
+	}
+	return append(headers, "Total")
 }
 
-func (m Model) setTableData() [][]string {
-	// Aggregate daily totals from entries
-	dailyTotals := make(map[string]time.Duration)
+// dailyTotals sums the time on each day, keyed by date
+func (m Model) dailyTotals() map[string]time.Duration {
+	totals := make(map[string]time.Duration)
 	for _, entry := range m.entries {
-		if entry.TimeInterval.End.IsZero() {
-			continue
+		// Overnight entries are split at midnight
+		for _, day := range entry.Days(time.Local) {
+			totals[day.Format("2006-01-02")] += entry.DurationOn(day)
 		}
-		duration := entry.TimeInterval.End.Sub(entry.TimeInterval.Start)
-		day := entry.TimeInterval.Start.Format("2006-01-02")
-		dailyTotals[day] += duration
 	}
+	return totals
+}
 
+// weeks lays the month out in weeks from the schedule's first day, zero for days outside it
+func (m Model) weeks() [][7]time.Time {
 	startOfMonth := time.Date(m.currentMonth.Year(), m.currentMonth.Month(), 1, 0, 0, 0, 0, time.Local)
 	daysInMonth := time.Date(m.currentMonth.Year(), m.currentMonth.Month()+1, 0, 0, 0, 0, 0, time.Local).Day()
 
-	// Group days into Mon–Fri weeks
-	type week struct {
-		label string
-		days  [5]time.Time
-	}
-
-	var weeks []week
-	var current *week
-	weekNum := 0
-
+	var weeks [][7]time.Time
 	for i := range daysInMonth {
 		day := startOfMonth.AddDate(0, 0, i)
-		weekday := day.Weekday()
-
-		if weekday == time.Saturday || weekday == time.Sunday {
-			continue
-		}
+		idx := (int(day.Weekday()) - int(m.config.Schedule.FirstDay()) + 7) % 7
 
-		if weekday == time.Monday || current == nil {
-			weekNum++
-			weeks = append(weeks, week{})
-			current = &weeks[len(weeks)-1]
+		if idx == 0 || len(weeks) == 0 {
+			weeks = append(weeks, [7]time.Time{})
 		}
-
-		idx := int(weekday) - 1 // Mon=0, Tue=1, ..., Fri=4
-		current.days[idx] = day
+		weeks[len(weeks)-1][idx] = day
 	}
+	return weeks
+}
 
-	// Build rows
+func (m Model) setTableData() [][]string {
+	dailyTotals := m.dailyTotals()
+
+	// Build rows, time on days off counts toward the week but only working days outside holidays and leave set its target
 	rows := [][]string{}
-	for _, w := range weeks {
-		var weekTotal time.Duration
-		var weekMax int
+	for _, w := range m.weeks() {
+		var weekTotal, weekMax time.Duration
 		row := []string{}
-		for _, day := range w.days {
+		for _, day := range w {
 			if day.IsZero() {
 				row = append(row, "\n")
 				continue
 			}
 			key := day.Format("2006-01-02")
 			d := dailyTotals[key]
-			weekMax += 8
+			weekMax += m.daysOff.Target(m.config.Schedule, day)
 			weekTotal += d
 			date := day.Format("01/02")
 			row = append(row, fmt.Sprintf("%s\n%s", date, formatDuration(d)))
 		}
-		row = append(row, fmt.Sprintf("\n%s/%dh", formatDuration(weekTotal), weekMax))
+		row = append(row, fmt.Sprintf("\n%s/%s", formatDuration(weekTotal), formatTarget(weekMax)))
 		rows = append(rows, row)
 	}
 
 	return rows
 }
 
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// formatTarget shows an empty target as 0h rather than formatDuration's dash
+func formatTarget(d time.Duration) string {
+	if d == 0 {
+		return "0h"
+	}
+	return formatDuration(d)
+}
+
 func formatDuration(d time.Duration) string {
 	if d == 0 {
 		return "-"
@@ -0,0 +1,265 @@
+package month
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/balance"
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func at(month time.Month, day, hour int) time.Time {
+	return time.Date(2026, month, day, hour, 0, 0, 0, time.Local)
+}
+
+func entryAt(id string, start time.Time, length time.Duration) models.Entry {
+	return models.Entry{
+		ID:          id,
+		Description: "Work " + id,
+		TimeInterval: models.IntervalTime{
+			Start: start,
+			End:   start.Add(length),
+		},
+	}
+}
+
+// loaded returns October 2026 on Wednesday the 14th with the given entries
+func loaded(entries ...models.Entry) Model {
+	m := New(&config.Config{})
+	m.now = func() time.Time { return at(time.October, 14, 12) }
+	m.currentMonth = at(time.October, 1, 0)
+	m.selected = at(time.October, 14, 0)
+
+	start, end := api.MonthRange(m.currentMonth)
+	m, _ = m.Update(messages.EntriesLoadedMsg{Entries: entries, Start: start, End: end})
+	return m
+}
+
+func press(m Model, key rune) (Model, tea.Cmd) {
+	return m.Update(tea.KeyPressMsg{Code: key, Text: string(key)})
+}
+
+func TestWeeksIncludeWeekends(t *testing.T) {
+	m := loaded(
+		entryAt("sat", at(time.October, 10, 9), 3*time.Hour),
+		entryAt("mon", at(time.October, 12, 9), 8*time.Hour),
+	)
+
+	weeks := m.weeks()
+	// October 1st 2026 is a Thursday, weeks start on Sunday by default
+	if len(weeks) != 5 || !weeks[0][4].Equal(at(time.October, 1, 0)) || !weeks[0][0].IsZero() {
+		t.Fatalf("Unexpected layout %v", weeks[0])
+	}
+	if !weeks[1][6].Equal(at(time.October, 10, 0)) || !weeks[2][0].Equal(at(time.October, 11, 0)) {
+		t.Errorf("Expected Saturday last and Sunday first, got %v", weeks[1])
+	}
+
+	rows := m.setTableData()
+	if len(rows[0]) != 8 {
+		t.Fatalf("Expected 7 days and a total, got %d columns", len(rows[0]))
+	}
+	// Saturday's time counts toward its week, the target only covers weekdays
+	if total := rows[1][7]; !strings.Contains(total, "3h/40h") {
+		t.Errorf("Expected the weekend hours in the week total, got %q", total)
+	}
+	if total := rows[2][7]; !strings.Contains(total, "8h/40h") {
+		t.Errorf("Expected Monday's hours in the week total, got %q", total)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	m := loaded(entryAt("sat", at(time.October, 10, 9), 3*time.Hour))
+	m.config.Schedule = config.Schedule{
+		WeekStart:   "monday",
+		WorkingDays: []string{"mon", "tue", "wed", "thu"},
+		DailyHours:  map[string]float64{"thu": 4},
+		PartTime:    50,
+	}
+
+	if headers := m.tableHeaders(); headers[0] != "Mon" || headers[6] != "Sun" {
+		t.Errorf("Expected weeks from Monday, got %v", headers)
+	}
+	weeks := m.weeks()
+	if !weeks[0][3].Equal(at(time.October, 1, 0)) {
+		t.Errorf("Expected Thursday the 1st in the fourth column, got %v", weeks[0])
+	}
+
+	// Three half days of 4h and a half day of 2h
+	rows := m.setTableData()
+	if total := rows[1][7]; !strings.Contains(total, "3h/14h") {
+		t.Errorf("Expected a 14h target for the week, got %q", total)
+	}
+	if footer := m.renderFooter(); !strings.Contains(footer, "3h / 58h") {
+		t.Errorf("Expected a 58h target for October, got %q", footer)
+	}
+}
+
+func TestHeat(t *testing.T) {
+	tests := []struct {
+		hours  time.Duration
+		target time.Duration
+		shade  int
+	}{
+		{time.Hour, 8 * time.Hour, 0},
+		{3 * time.Hour, 8 * time.Hour, 1},
+		{6 * time.Hour, 8 * time.Hour, 3},
+		{8 * time.Hour, 8 * time.Hour, 4},
+		{11 * time.Hour, 8 * time.Hour, 4},
+		{2 * time.Hour, 4 * time.Hour, 2},
+		{time.Hour, 0, 4},
+	}
+	for _, test := range tests {
+		shade, ok := heat(test.hours, test.target)
+		if !ok || shade != Heat[test.shade] {
+			t.Errorf("heat(%v, %v) = %v; want shade %d", test.hours, test.target, shade, test.shade)
+		}
+	}
+	if _, ok := heat(0, 8*time.Hour); ok {
+		t.Error("Expected no shade for an empty day")
+	}
+}
+
+func TestSelectDay(t *testing.T) {
+	m := loaded(entryAt("a", at(time.October, 7, 9), time.Hour))
+
+	m, _ = press(m, 'k')
+	if !m.selected.Equal(at(time.October, 7, 0)) {
+		t.Errorf("Expected the week before, got %v", m.selected)
+	}
+	if entries := m.dayEntries(); len(entries) != 1 || entries[0].ID != "a" {
+		t.Errorf("Expected the day's entry, got %v", entries)
+	}
+
+	// Moving out of the month pages to it
+	m.selected = at(time.October, 31, 0)
+	m, cmd := press(m, 'l')
+	if cmd == nil || m.ready || m.currentMonth.Month() != time.November || !m.selected.Equal(at(time.November, 1, 0)) {
+		t.Errorf("Expected November 1st to load, got %v", m.selected)
+	}
+
+	// Paging keeps the day of the month where it can
+	m.selected = at(time.November, 30, 0)
+	m, _ = press(m, '[')
+	if !m.selected.Equal(at(time.October, 30, 0)) {
+		t.Errorf("Expected October 30th, got %v", m.selected)
+	}
+	m.selected = at(time.October, 31, 0)
+	m, _ = press(m, '[')
+	if !m.selected.Equal(at(time.September, 30, 0)) {
+		t.Errorf("Expected September 30th, got %v", m.selected)
+	}
+}
+
+func TestDrillDown(t *testing.T) {
+	m := loaded(
+		entryAt("late", at(time.October, 14, 13), time.Hour),
+		entryAt("early", at(time.October, 14, 9), time.Hour),
+	)
+
+	if !strings.Contains(m.View().Content, "Work early") {
+		t.Error("Expected the selected day's entries to be listed")
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !m.listFocused {
+		t.Fatal("Expected enter to focus the day's entries")
+	}
+	m, _ = press(m, 'j')
+
+	_, cmd := press(m, 'e')
+	if msg, ok := cmd().(messages.EntryUpdateStartedMsg); !ok || msg.Entry.ID != "late" {
+		t.Errorf("Expected the later entry to be edited, got %#v", msg)
+	}
+	_, cmd = press(m, 'c')
+	if msg, ok := cmd().(messages.EntryCopyStartedMsg); !ok || msg.Entry.ID != "late" {
+		t.Errorf("Expected the later entry to be copied, got %#v", msg)
+	}
+	_, cmd = press(m, 'd')
+	if msg, ok := cmd().(messages.EntryDeleteStartedMsg); !ok || msg.EntryId != "late" {
+		t.Errorf("Expected the later entry to be deleted, got %#v", msg)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.listFocused {
+		t.Error("Expected esc to return to the calendar")
+	}
+}
+
+func TestDaysOffLowerTargets(t *testing.T) {
+	m := loaded(entryAt("mon", at(time.October, 5, 9), 8*time.Hour))
+	start, end := api.MonthRange(m.currentMonth)
+	m, _ = m.Update(messages.DaysOffLoadedMsg{
+		Days: []models.DayOff{
+			{Date: at(time.October, 12, 0), Name: "Columbus Day"},
+			{Date: at(time.October, 14, 0), Name: "Leave", Half: true},
+		},
+		Start: start,
+		End:   end,
+	})
+
+	// October has 22 working days, less a holiday and half a day of leave
+	if footer := m.renderFooter(); !strings.Contains(footer, "8h / 164h") {
+		t.Errorf("Expected a 164h target for October, got %q", footer)
+	}
+	rows := m.setTableData()
+	if total := rows[2][7]; !strings.Contains(total, "-/28h") {
+		t.Errorf("Expected a 28h target for the week of the 11th, got %q", total)
+	}
+	if legend := m.renderDaysOff(); !strings.Contains(legend, "10/12 Columbus Day") {
+		t.Errorf("Expected the holiday to be listed, got %q", legend)
+	}
+	if day := m.renderDay(); !strings.Contains(day, "Leave (half day)") {
+		t.Errorf("Expected the selected day to name its leave, got %q", day)
+	}
+}
+
+func TestBalanceFooter(t *testing.T) {
+	m := loaded()
+	if footer := m.renderFooter(); strings.Contains(footer, "Balance") {
+		t.Errorf("Expected no balance before it loads, got %q", footer)
+	}
+
+	m, _ = m.Update(messages.BalanceLoadedMsg{Ledger: balance.Ledger{
+		Start:  at(time.January, 1, 0),
+		Actual: 1500 * time.Hour,
+		Target: 1512 * time.Hour,
+	}})
+	footer := m.renderFooter()
+	if !strings.Contains(footer, "-12h") || !strings.Contains(footer, "since Jan 1, 2026") {
+		t.Errorf("Expected to be 12h behind since January 1, got %q", footer)
+	}
+}
+
+func TestInitReusesBalance(t *testing.T) {
+	m := loaded()
+	m, _ = m.Update(messages.BalanceLoadedMsg{Ledger: balance.Ledger{
+		Start: at(time.January, 1, 0),
+		End:   at(time.October, 15, 0),
+	}})
+
+	// Showing the month again keeps the balance that's already up to today
+	m.Init()
+	if m.cancelLedger != nil {
+		t.Error("Expected the loaded balance to be reused")
+	}
+
+	// Changed entries have it fetched again, cancellably
+	m.InvalidateBalance()
+	m.Init()
+	if m.cancelLedger == nil || m.ledgerStale {
+		t.Error("Expected the balance to be fetched again after entries changed")
+	}
+
+	// So does a new day
+	m.cancelLedger = nil
+	m.now = func() time.Time { return at(time.October, 15, 9) }
+	m.Init()
+	if m.cancelLedger == nil {
+		t.Error("Expected the balance to be fetched again on a new day")
+	}
+}
@@ -32,7 +32,10 @@ func New(cfg *config.Config, project models.Project, tasks []models.Task) Model
 }
 
 func (m Model) Init() tea.Cmd {
-	return api.FetchTasks(m.config.APIKey, m.config.WorkspaceId, m.project.ID)
+	return tea.Batch(
+		api.FetchTasks(m.config.APIKey, m.config.WorkspaceId, m.project.ID),
+		api.RevalidateTasks(m.config.APIKey, m.config.WorkspaceId, m.project.ID),
+	)
 }
 
 func (m Model) Update(msg any) (Model, tea.Cmd) {
@@ -51,6 +54,9 @@ func (m Model) Update(msg any) (Model, tea.Cmd) {
 		}
 
 	case messages.TasksLoadedMsg:
+		if msg.ProjectID != m.project.ID {
+			break
+		}
 		m.tasks = msg.Tasks
 		m.ready = true
 	}
@@ -53,9 +53,15 @@ func New(cfg *config.Config) Model {
 }
 
 func (m Model) Init() tea.Cmd {
-	return api.FetchProjects(
-		m.config.APIKey,
-		m.config.WorkspaceId,
+	return tea.Batch(
+		api.FetchProjects(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
+		api.RevalidateProjects(
+			m.config.APIKey,
+			m.config.WorkspaceId,
+		),
 	)
 }
 
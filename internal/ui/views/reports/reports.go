@@ -1 +1,619 @@
 package reports
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"clockify-app/internal/styles"
+	"clockify-app/internal/utils"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/mattn/go-runewidth"
+)
+
+// rangePreset controls how the report range is built and shifted
+type rangePreset int
+
+const (
+	presetWeek rangePreset = iota
+	presetMonth
+	presetYear
+	presetCustom
+)
+
+func (p rangePreset) String() string {
+	switch p {
+	case presetWeek:
+		return "Week"
+	case presetMonth:
+		return "Month"
+	case presetYear:
+		return "Year"
+	}
+	return "Custom"
+}
+
+// groupBy is the dimension the report totals are grouped by
+type groupBy int
+
+const (
+	groupProject groupBy = iota
+	groupClient
+	groupTask
+	groupDescription
+	groupDay
+)
+
+func (g groupBy) String() string {
+	switch g {
+	case groupProject:
+		return "Project"
+	case groupClient:
+		return "Client"
+	case groupTask:
+		return "Task"
+	case groupDescription:
+		return "Description"
+	}
+	return "Day"
+}
+
+const dateLayout = "2006-01-02"
+
+var (
+	BarWidth = 20
+
+	ReportStyle = lipgloss.NewStyle().Padding(1, 2)
+
+	headerStyle = lipgloss.NewStyle().
+			Foreground(styles.Primary).
+			Bold(true)
+
+	billableStyle    = lipgloss.NewStyle().Foreground(styles.Primary)
+	nonBillableStyle = lipgloss.NewStyle().Foreground(styles.Secondary)
+	emptyBarStyle    = lipgloss.NewStyle().Foreground(styles.Muted)
+)
+
+// reportRow holds the totals for a single group
+type reportRow struct {
+	label       string
+	sortKey     string
+	billable    time.Duration
+	nonBillable time.Duration
+}
+
+func (r reportRow) total() time.Duration {
+	return r.billable + r.nonBillable
+}
+
+type Model struct {
+	config   *config.Config
+	entries  []models.Entry
+	projects []models.Project
+	tasks    map[string][]models.Task // map[ProjectID][]Task
+
+	// Selected range, start inclusive and end exclusive
+	preset rangePreset
+	start  time.Time
+	end    time.Time
+	group  groupBy
+
+	// Custom range inputs
+	editing   bool
+	fromInput textinput.Model
+	toInput   textinput.Model
+	rangeErr  string
+
+	width  int
+	height int
+	ready  bool
+}
+
+func New(cfg *config.Config) Model {
+	fromInput := textinput.New()
+	fromInput.Placeholder = dateLayout
+	fromInput.CharLimit = 10
+	fromInput.SetWidth(12)
+
+	toInput := textinput.New()
+	toInput.Placeholder = dateLayout
+	toInput.CharLimit = 10
+	toInput.SetWidth(12)
+
+	m := Model{
+		config:    cfg,
+		entries:   []models.Entry{},
+		tasks:     make(map[string][]models.Task),
+		preset:    presetWeek,
+		group:     groupProject,
+		fromInput: fromInput,
+		toInput:   toInput,
+	}
+	m.start, m.end = presetRange(presetWeek, time.Now(), cfg.Schedule.FirstDay())
+
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	return m.fetchEntries()
+}
+
+func (m *Model) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// Editing reports whether the custom range inputs are capturing keys
+func (m Model) Editing() bool {
+	return m.editing
+}
+
+func (m Model) fetchEntries() tea.Cmd {
+	start, end := m.fetchRange()
+	return api.FetchEntriesForRange(
+		context.Background(),
+		m.config.APIKey,
+		m.config.WorkspaceId,
+		m.config.UserId,
+		start,
+		end,
+	)
+}
+
+// fetchRange starts a day before the report, so entries running overnight into it are included
+func (m Model) fetchRange() (time.Time, time.Time) {
+	return m.start.Add(-api.MaxEntryDuration), m.end
+}
+
+// fetchMissingTasks loads tasks for the projects in the report that we don't know yet
+// Task names are only needed when grouping by task
+func (m Model) fetchMissingTasks() tea.Cmd {
+	if m.group != groupTask {
+		return nil
+	}
+
+	var missing []models.Project
+	seen := make(map[string]bool)
+	for _, entry := range m.entries {
+		if entry.ProjectID == "" || entry.TaskID == "" || seen[entry.ProjectID] {
+			continue
+		}
+		seen[entry.ProjectID] = true
+		if _, ok := m.tasks[entry.ProjectID]; !ok {
+			missing = append(missing, models.Project{ID: entry.ProjectID})
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return api.FetchTasksForAllProjects(m.config.APIKey, m.config.WorkspaceId, missing)
+}
+
+// presetRange returns the period of the given preset that contains day
+// Weeks start on firstDay, the schedule's first day of the week.
+func presetRange(preset rangePreset, day time.Time, firstDay time.Weekday) (time.Time, time.Time) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.Local)
+
+	switch preset {
+	case presetMonth:
+		start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.Local)
+		return start, start.AddDate(0, 1, 0)
+	case presetYear:
+		start := time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.Local)
+		return start, start.AddDate(1, 0, 0)
+	}
+
+	start := day.AddDate(0, 0, -((int(day.Weekday()) - int(firstDay) + 7) % 7))
+	return start, start.AddDate(0, 0, 7)
+}
+
+// shiftRange moves the selected range by one period in the given direction
+func (m *Model) shiftRange(direction int) {
+	switch m.preset {
+	case presetWeek:
+		m.start = m.start.AddDate(0, 0, 7*direction)
+		m.end = m.end.AddDate(0, 0, 7*direction)
+	case presetMonth:
+		m.start = m.start.AddDate(0, direction, 0)
+		m.end = m.end.AddDate(0, direction, 0)
+	case presetYear:
+		m.start = m.start.AddDate(direction, 0, 0)
+		m.end = m.end.AddDate(direction, 0, 0)
+	default:
+		days := int(math.Round(m.end.Sub(m.start).Hours() / 24))
+		m.start = m.start.AddDate(0, 0, days*direction)
+		m.end = m.end.AddDate(0, 0, days*direction)
+	}
+}
+
+// applyCustomRange validates the custom inputs and uses them as the range
+func (m *Model) applyCustomRange() bool {
+	from, err := time.ParseInLocation(dateLayout, strings.TrimSpace(m.fromInput.Value()), time.Local)
+	if err != nil {
+		m.rangeErr = "Invalid start date. Use YYYY-MM-DD."
+		return false
+	}
+
+	to, err := time.ParseInLocation(dateLayout, strings.TrimSpace(m.toInput.Value()), time.Local)
+	if err != nil {
+		m.rangeErr = "Invalid end date. Use YYYY-MM-DD."
+		return false
+	}
+
+	if to.Before(from) {
+		m.rangeErr = "End date must not be before start date."
+		return false
+	}
+
+	m.preset = presetCustom
+	m.start = from
+	m.end = to.AddDate(0, 0, 1) // Include the whole end day
+	m.rangeErr = ""
+	return true
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		if m.editing {
+			return m.updateEditing(msg)
+		}
+
+		switch msg.String() {
+		case "h", "left":
+			m.shiftRange(-1)
+			m.ready = false
+			return m, m.fetchEntries()
+
+		case "l", "right":
+			m.shiftRange(1)
+			m.ready = false
+			return m, m.fetchEntries()
+
+		case "r":
+			// Cycle through the presets, starting from the period containing today
+			if m.preset == presetCustom {
+				m.preset = presetWeek
+			} else {
+				m.preset = (m.preset + 1) % presetCustom
+			}
+			m.start, m.end = presetRange(m.preset, time.Now(), m.config.Schedule.FirstDay())
+			m.ready = false
+			return m, m.fetchEntries()
+
+		case "g":
+			m.group = (m.group + 1) % (groupDay + 1)
+			return m, m.fetchMissingTasks()
+
+		case "c":
+			m.editing = true
+			m.rangeErr = ""
+			m.fromInput.SetValue(m.start.Format(dateLayout))
+			m.toInput.SetValue(m.end.AddDate(0, 0, -1).Format(dateLayout))
+			m.toInput.Blur()
+			return m, m.fromInput.Focus()
+		}
+
+	case messages.EntriesLoadedMsg:
+		// Ignore results for a range that is no longer selected
+		if !msg.ForRange(m.fetchRange()) {
+			return m, nil
+		}
+		m.entries = msg.Entries
+		m.ready = true
+		return m, m.fetchMissingTasks()
+
+	case messages.ProjectsLoadedMsg:
+		m.projects = msg.Projects
+
+	case messages.AllTasksLoadedMsg:
+		for projectID, tasks := range msg.Tasks {
+			m.tasks[projectID] = tasks
+		}
+	}
+
+	// Keep the focused input blinking
+	if m.editing {
+		if m.fromInput.Focused() {
+			m.fromInput, cmd = m.fromInput.Update(msg)
+		} else {
+			m.toInput, cmd = m.toInput.Update(msg)
+		}
+	}
+
+	return m, cmd
+}
+
+func (m Model) updateEditing(msg tea.KeyPressMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.String() {
+	case "esc":
+		m.editing = false
+		m.rangeErr = ""
+		m.fromInput.Blur()
+		m.toInput.Blur()
+		return m, nil
+
+	case "tab", "shift+tab":
+		if m.fromInput.Focused() {
+			m.fromInput.Blur()
+			return m, m.toInput.Focus()
+		}
+		m.toInput.Blur()
+		return m, m.fromInput.Focus()
+
+	case "enter":
+		if !m.applyCustomRange() {
+			return m, nil
+		}
+		m.editing = false
+		m.ready = false
+		m.fromInput.Blur()
+		m.toInput.Blur()
+		return m, m.fetchEntries()
+	}
+
+	if m.fromInput.Focused() {
+		m.fromInput, cmd = m.fromInput.Update(msg)
+	} else {
+		m.toInput, cmd = m.toInput.Update(msg)
+	}
+
+	return m, cmd
+}
+
+// summarize groups the time entries spend in the days from start up to end into report rows
+// Overnight entries count toward each day they cover, and time outside the range doesn't count.
+// Rows are ordered by total time, except day groups which are chronological
+func summarize(entries []models.Entry, projects []models.Project, tasks map[string][]models.Task, group groupBy, start, end time.Time) []reportRow {
+	rows := make(map[string]*reportRow)
+	var order []string
+
+	for _, entry := range entries {
+		for _, day := range entry.Days(time.Local) {
+			if day.Before(start) || !day.Before(end) {
+				continue
+			}
+			duration := entry.DurationOn(day)
+			if duration == 0 {
+				continue
+			}
+
+			key, label, sortKey := groupKey(entry, day, projects, tasks, group)
+			row, ok := rows[key]
+			if !ok {
+				row = &reportRow{label: label, sortKey: sortKey}
+				rows[key] = row
+				order = append(order, key)
+			}
+
+			if entry.Billable {
+				row.billable += duration
+			} else {
+				row.nonBillable += duration
+			}
+		}
+	}
+
+	result := make([]reportRow, 0, len(order))
+	for _, key := range order {
+		result = append(result, *rows[key])
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if group == groupDay {
+			return result[i].sortKey < result[j].sortKey
+		}
+		if result[i].total() != result[j].total() {
+			return result[i].total() > result[j].total()
+		}
+		return result[i].label < result[j].label
+	})
+
+	return result
+}
+
+// groupKey returns the grouping key, display label and sort key for an entry's time on day
+func groupKey(entry models.Entry, day time.Time, projects []models.Project, tasks map[string][]models.Task, group groupBy) (string, string, string) {
+	switch group {
+	case groupProject:
+		project, err := utils.FindProjectById(projects, entry.ProjectID)
+		if err != nil {
+			return entry.ProjectID, "No Project", ""
+		}
+		return project.ID, project.Name, ""
+
+	case groupClient:
+		project, _ := utils.FindProjectById(projects, entry.ProjectID)
+		if project.ClientName == "" {
+			return "", "No Client", ""
+		}
+		return project.ClientName, project.ClientName, ""
+
+	case groupTask:
+		if entry.TaskID == "" {
+			return "", "No Task", ""
+		}
+		for _, task := range tasks[entry.ProjectID] {
+			if task.ID == entry.TaskID {
+				return task.ID, task.Name, ""
+			}
+		}
+		return entry.TaskID, "Unknown Task", ""
+
+	case groupDescription:
+		if entry.Description == "" {
+			return "", "(No Description)", ""
+		}
+		return entry.Description, entry.Description, ""
+	}
+
+	return day.Format(dateLayout), day.Format("Mon, Jan 2"), day.Format(dateLayout)
+}
+
+func (m Model) View() tea.View {
+	var b strings.Builder
+
+	b.WriteString(styles.TitleStyle.MarginBottom(0).Render("Report"))
+	b.WriteString("\n")
+	b.WriteString(styles.SubtitleStyle.Render(fmt.Sprintf(
+		"%s · %s · Grouped by %s",
+		m.rangeLabel(),
+		m.preset,
+		m.group,
+	)))
+	b.WriteString("\n\n")
+
+	if m.editing {
+		b.WriteString(m.viewCustomRange())
+		b.WriteString("\n\n")
+	}
+
+	if !m.ready {
+		b.WriteString("Loading entries...")
+	} else {
+		b.WriteString(m.viewReport())
+	}
+
+	b.WriteString("\n")
+	b.WriteString(styles.HelpStyle.Render("h/l: previous/next • r: week/month/year • g: group by • c: custom range"))
+
+	return tea.NewView(ReportStyle.Render(b.String()))
+}
+
+// rangeLabel renders the selected range, showing the end day inclusively
+func (m Model) rangeLabel() string {
+	last := m.end.AddDate(0, 0, -1)
+	if m.start.Year() == last.Year() {
+		return fmt.Sprintf("%s – %s", m.start.Format("Jan 2"), last.Format("Jan 2, 2006"))
+	}
+	return fmt.Sprintf("%s – %s", m.start.Format("Jan 2, 2006"), last.Format("Jan 2, 2006"))
+}
+
+func (m Model) viewCustomRange() string {
+	lines := []string{
+		fmt.Sprintf("From: %s", m.fromInput.View()),
+		fmt.Sprintf("To:   %s", m.toInput.View()),
+	}
+	if m.rangeErr != "" {
+		lines = append(lines, styles.ErrorStyle.Render(m.rangeErr))
+	}
+	lines = append(lines, styles.MutedTextStyle.Render("tab: switch • enter: apply • esc: cancel"))
+
+	return styles.BoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m Model) viewReport() string {
+	rows := summarize(m.entries, m.projects, m.tasks, m.group, m.start, m.end)
+	if len(rows) == 0 {
+		return styles.MutedTextStyle.Render("No time tracked in this range.")
+	}
+
+	var grand, billable time.Duration
+	for _, row := range rows {
+		grand += row.total()
+		billable += row.billable
+	}
+
+	// Everything but the label column has a fixed width
+	frameWidth, _ := ReportStyle.GetFrameSize()
+	labelWidth := max(20, m.width-frameWidth-(10*3)-BarWidth-8)
+
+	var b strings.Builder
+
+	// Compare with what the schedule expects, when it expects anything
+	total := formatDuration(grand)
+	if target := m.config.Schedule.TargetBetween(m.start, m.end); target > 0 {
+		total += " of " + formatDuration(target) + " target"
+	}
+
+	b.WriteString(fmt.Sprintf(
+		"Total %s · %s %s (%d%%) · %s %s\n\n",
+		total,
+		billableStyle.Render("Billable"),
+		formatDuration(billable),
+		percent(billable, grand),
+		nonBillableStyle.Render("Non-billable"),
+		formatDuration(grand-billable),
+	))
+
+	b.WriteString(headerStyle.Render(
+		padRight(m.group.String(), labelWidth) +
+			padLeft("Total", 10) +
+			padLeft("Billable", 10) +
+			padLeft("Non-bill", 10) +
+			"  Share",
+	))
+	b.WriteString("\n")
+
+	for _, row := range rows {
+		b.WriteString(padRight(runewidth.Truncate(row.label, labelWidth-1, "…"), labelWidth))
+		b.WriteString(padLeft(formatDuration(row.total()), 10))
+		b.WriteString(padLeft(formatDuration(row.billable), 10))
+		b.WriteString(padLeft(formatDuration(row.nonBillable), 10))
+		b.WriteString("  ")
+		b.WriteString(renderBar(row, grand))
+		b.WriteString(fmt.Sprintf(" %3d%%\n", percent(row.total(), grand)))
+	}
+
+	return b.String()
+}
+
+// renderBar draws the share of the grand total, split into billable and non-billable parts
+func renderBar(row reportRow, grand time.Duration) string {
+	if grand <= 0 {
+		return emptyBarStyle.Render(strings.Repeat("░", BarWidth))
+	}
+
+	filled := int(math.Round(float64(row.total()) / float64(grand) * float64(BarWidth)))
+	billableCells := int(math.Round(float64(row.billable) / float64(grand) * float64(BarWidth)))
+	billableCells = min(billableCells, filled)
+
+	return billableStyle.Render(strings.Repeat("█", billableCells)) +
+		nonBillableStyle.Render(strings.Repeat("█", filled-billableCells)) +
+		emptyBarStyle.Render(strings.Repeat("░", BarWidth-filled))
+}
+
+func percent(part, whole time.Duration) int {
+	if whole <= 0 {
+		return 0
+	}
+	return int(math.Round(float64(part) / float64(whole) * 100))
+}
+
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-runewidth.StringWidth(s)))
+}
+
+func padLeft(s string, width int) string {
+	return strings.Repeat(" ", max(0, width-runewidth.StringWidth(s))) + s
+}
+
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	if h == 0 {
+		return fmt.Sprintf("%dm", m)
+	}
+	if m == 0 {
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh %dm", h, m)
+}
@@ -0,0 +1,244 @@
+package reports
+
+import (
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func entryAt(start time.Time, length time.Duration, projectID, description string, billable bool) models.Entry {
+	return models.Entry{
+		Description: description,
+		ProjectID:   projectID,
+		Billable:    billable,
+		TimeInterval: models.IntervalTime{
+			Start: start,
+			End:   start.Add(length),
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	model := New(&config.Config{})
+
+	if model.preset != presetWeek {
+		t.Errorf("Expected week preset, got %v", model.preset)
+	}
+	if model.group != groupProject {
+		t.Errorf("Expected project grouping, got %v", model.group)
+	}
+	if model.start.Weekday() != time.Sunday {
+		t.Errorf("Week should start on Sunday, got %v", model.start.Weekday())
+	}
+	if got := model.end.Sub(model.start); got < 167*time.Hour || got > 169*time.Hour {
+		t.Errorf("Week range should be 7 days, got %v", got)
+	}
+}
+
+func TestPresetRange(t *testing.T) {
+	day := time.Date(2026, 10, 15, 14, 30, 0, 0, time.Local) // Thursday
+
+	start, end := presetRange(presetWeek, day, time.Sunday)
+	if !start.Equal(time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local)) || !end.Equal(time.Date(2026, 10, 18, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Unexpected week range %v - %v", start, end)
+	}
+
+	// Weeks follow the schedule's first day
+	start, end = presetRange(presetWeek, day, time.Monday)
+	if !start.Equal(time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)) || !end.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Unexpected Monday week range %v - %v", start, end)
+	}
+
+	start, end = presetRange(presetMonth, day, time.Sunday)
+	if !start.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)) || !end.Equal(time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Unexpected month range %v - %v", start, end)
+	}
+
+	start, end = presetRange(presetYear, day, time.Sunday)
+	if !start.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)) || !end.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Unexpected year range %v - %v", start, end)
+	}
+}
+
+func TestShiftRange(t *testing.T) {
+	model := New(&config.Config{})
+	model.preset = presetMonth
+	model.start, model.end = presetRange(presetMonth, time.Date(2026, 1, 20, 0, 0, 0, 0, time.Local), time.Sunday)
+
+	model.shiftRange(-1)
+	if model.start.Month() != time.December || model.start.Year() != 2025 {
+		t.Errorf("Expected December 2025, got %v", model.start)
+	}
+
+	// Custom ranges shift by their own length
+	model.preset = presetCustom
+	model.start = time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local)
+	model.end = time.Date(2026, 3, 11, 0, 0, 0, 0, time.Local)
+	model.shiftRange(1)
+	if !model.start.Equal(time.Date(2026, 3, 11, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("Expected custom range to move 10 days, got %v", model.start)
+	}
+}
+
+func TestApplyCustomRange(t *testing.T) {
+	model := New(&config.Config{})
+
+	model.fromInput.SetValue("2026-10-01")
+	model.toInput.SetValue("2026-10-15")
+	if !model.applyCustomRange() {
+		t.Fatalf("Expected valid range, got error %q", model.rangeErr)
+	}
+	if model.preset != presetCustom {
+		t.Error("Preset should be custom after applying a custom range")
+	}
+	if !model.end.Equal(time.Date(2026, 10, 16, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("End day should be inclusive, got %v", model.end)
+	}
+
+	model.fromInput.SetValue("2026-10-15")
+	model.toInput.SetValue("2026-10-01")
+	if model.applyCustomRange() {
+		t.Error("Expected error when end is before start")
+	}
+
+	model.fromInput.SetValue("yesterday")
+	if model.applyCustomRange() {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	day1 := time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local)
+	day2 := time.Date(2026, 10, 13, 9, 0, 0, 0, time.Local)
+
+	projects := []models.Project{
+		{ID: "p1", Name: "Website", ClientName: "Acme"},
+		{ID: "p2", Name: "Internal"},
+	}
+	entries := []models.Entry{
+		entryAt(day2, 2*time.Hour, "p1", "Build", true),
+		entryAt(day1, time.Hour, "p2", "Meeting", false),
+		entryAt(day1, 30*time.Minute, "p1", "Build", false),
+	}
+
+	start, end := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local), time.Date(2026, 10, 19, 0, 0, 0, 0, time.Local)
+	rows := summarize(entries, projects, nil, groupProject, start, end)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 project rows, got %d", len(rows))
+	}
+	if rows[0].label != "Website" || rows[0].billable != 2*time.Hour || rows[0].nonBillable != 30*time.Minute {
+		t.Errorf("Unexpected first row %+v", rows[0])
+	}
+
+	rows = summarize(entries, projects, nil, groupClient, start, end)
+	if len(rows) != 2 || rows[0].label != "Acme" || rows[1].label != "No Client" {
+		t.Errorf("Unexpected client rows %+v", rows)
+	}
+
+	// Days are listed chronologically rather than by total
+	rows = summarize(entries, projects, nil, groupDay, start, end)
+	if len(rows) != 2 || rows[0].total() != 90*time.Minute {
+		t.Errorf("Unexpected day rows %+v", rows)
+	}
+
+	tasks := map[string][]models.Task{"p1": {{ID: "t1", Name: "Frontend"}}}
+	entries[0].TaskID = "t1"
+	rows = summarize(entries, projects, tasks, groupTask, start, end)
+	if rows[0].label != "Frontend" {
+		t.Errorf("Expected task name, got %q", rows[0].label)
+	}
+}
+
+func TestSummarizeClipsToRange(t *testing.T) {
+	start, end := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local), time.Date(2026, 10, 14, 0, 0, 0, 0, time.Local)
+	entries := []models.Entry{
+		entryAt(start.Add(-2*time.Hour), 3*time.Hour, "p1", "Into the range", false),    // 1h inside
+		entryAt(start.Add(22*time.Hour), 4*time.Hour, "p1", "Overnight", false),         // 2h on each day
+		entryAt(end.Add(-30*time.Minute), 2*time.Hour, "p1", "Out of the range", false), // 30m inside
+		entryAt(start.Add(-26*time.Hour), time.Hour, "p1", "Before the range", false),   // None
+	}
+
+	rows := summarize(entries, nil, nil, groupProject, start, end)
+	if len(rows) != 1 || rows[0].total() != 5*time.Hour+30*time.Minute {
+		t.Errorf("Expected 5h 30m inside the range, got %+v", rows)
+	}
+
+	rows = summarize(entries, nil, nil, groupDay, start, end)
+	if len(rows) != 2 || rows[0].total() != 3*time.Hour || rows[1].total() != 2*time.Hour+30*time.Minute {
+		t.Errorf("Expected 3h and 2h 30m split at midnight, got %+v", rows)
+	}
+}
+
+func TestRenderBar(t *testing.T) {
+	row := reportRow{billable: time.Hour, nonBillable: time.Hour}
+	bar := renderBar(row, 4*time.Hour)
+	if strings.Count(bar, "█") != BarWidth/2 {
+		t.Errorf("Expected half the bar to be filled, got %q", bar)
+	}
+	if strings.Count(bar, "░") != BarWidth/2 {
+		t.Errorf("Expected half the bar to be empty, got %q", bar)
+	}
+}
+
+func TestUpdate_CycleGroupAndRange(t *testing.T) {
+	model := New(&config.Config{})
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'g'})
+	if model.group != groupClient {
+		t.Errorf("Expected client grouping after 'g', got %v", model.group)
+	}
+
+	model, cmd := model.Update(tea.KeyPressMsg{Code: 'r'})
+	if model.preset != presetMonth {
+		t.Errorf("Expected month preset after 'r', got %v", model.preset)
+	}
+	if cmd == nil {
+		t.Error("Changing the range should fetch entries")
+	}
+
+	model, _ = model.Update(tea.KeyPressMsg{Code: 'c'})
+	if !model.Editing() {
+		t.Error("'c' should open the custom range inputs")
+	}
+	model, _ = model.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	if model.Editing() {
+		t.Error("Esc should close the custom range inputs")
+	}
+}
+
+func TestView(t *testing.T) {
+	model := New(&config.Config{})
+	model.SetSize(120, 40)
+
+	if !strings.Contains(model.View().Content, "Loading entries...") {
+		t.Error("View should show loading state before entries arrive")
+	}
+
+	// Results for another range are ignored
+	model, _ = model.Update(messages.EntriesLoadedMsg{Entries: []models.Entry{}, Start: model.start.AddDate(0, 0, -7), End: model.start})
+	if !strings.Contains(model.View().Content, "Loading entries...") {
+		t.Error("View should ignore entries for a range that is not selected")
+	}
+
+	start, end := model.fetchRange()
+	model, _ = model.Update(messages.EntriesLoadedMsg{Entries: []models.Entry{}, Start: start, End: end})
+	if !strings.Contains(model.View().Content, "No time tracked") {
+		t.Error("View should show empty state without entries")
+	}
+
+	model, _ = model.Update(messages.EntriesLoadedMsg{Entries: []models.Entry{
+		entryAt(model.start.Add(9*time.Hour), time.Hour, "", "Planning", true),
+	}, Start: start, End: end})
+	if !strings.Contains(model.View().Content, "No Project") {
+		t.Error("View should list the report rows")
+	}
+	// A full-time week expects five 8h days
+	if !strings.Contains(model.View().Content, "Total 1h of 40h target") {
+		t.Error("View should compare the total with the schedule's target")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
 	"clockify-app/internal/styles"
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -221,6 +223,14 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case messages.ErrorMsg:
 		m.saving = false
 		m.err = msg.Err
+
+		// A rejected key needs re-entering, so unlock and focus it
+		if errors.Is(msg.Err, api.ErrUnauthorized) {
+			m.showWorkspacesList = false
+			m.apiKeyLocked = false
+			m.currentIndex = apiKeyInput
+			return m, m.updateFocus()
+		}
 		return m, nil
 	}
 
@@ -291,7 +301,7 @@ func (m Model) View() tea.View {
 		b.WriteString(styles.SuccessStyle.Render("✓ Configuration saved successfully!"))
 		b.WriteString("\n")
 	} else if m.err != nil {
-		b.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("✗ Error: %s", m.err.Error())))
+		b.WriteString(styles.ErrorStyle.Render(errorText(m.err)))
 		b.WriteString("\n")
 	}
 
@@ -407,11 +417,23 @@ func (m Model) updateFocus() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// errorText explains the errors a user can fix from this screen
+func errorText(err error) string {
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		return "✗ Invalid API key. Generate a new one under Profile Settings in Clockify."
+	case errors.Is(err, api.ErrForbidden):
+		return "✗ This API key has no access to that workspace."
+	default:
+		return fmt.Sprintf("✗ Error: %s", api.ErrorMessage(err))
+	}
+}
+
 // Helper to fetch workspaces based on API key
 func (m Model) fetchWorkspaces() tea.Cmd {
 	return func() tea.Msg {
 		client := api.NewClient(m.apiKeyInput.Value())
-		workspaces, err := client.GetWorkspaces()
+		workspaces, err := client.GetWorkspaces(context.Background())
 		if err != nil {
 			return messages.ErrorMsg{Err: err}
 		}
@@ -3,11 +3,14 @@ package week
 import (
 	"clockify-app/internal/api"
 	"clockify-app/internal/config"
+	"clockify-app/internal/holidays"
 	"clockify-app/internal/messages"
 	"clockify-app/internal/models"
 	"clockify-app/internal/styles"
 	"clockify-app/internal/utils"
+	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,16 +23,29 @@ var TableStyle = lipgloss.NewStyle().Padding(1, 2)
 
 var ColumnWidth = 11
 
+// row is one project's line of the grid
+type row struct {
+	projectID string
+	name      string
+	entries   []models.Entry
+}
+
 type Model struct {
-	config          *config.Config
-	entries         []models.Entry
-	projects        []models.Project
-	table           *table.Table
-	weekStart       time.Time
-	projectColWidth int
-	width           int
-	height          int
-	ready           bool
+	config       *config.Config
+	entries      []models.Entry
+	projects     []models.Project
+	table        *table.Table
+	weekStart    time.Time          // Midnight of the schedule's first day of the week
+	daysOff      holidays.Calendar  // Holidays and leave in the displayed week
+	cancelFetch  context.CancelFunc // Cancels the in-flight fetch when paging
+	row          int                // Selected project row
+	col          int                // Selected day, an index into days()
+	entryCursor  int                // Selected entry in the cell's panel
+	panelFocused bool               // Whether j/k move through the panel instead of the grid
+	now          func() time.Time
+	width        int
+	height       int
+	ready        bool
 }
 
 var (
@@ -40,6 +56,16 @@ var (
 
 	cellStyle = lipgloss.NewStyle().Padding(0, 1).Align(lipgloss.Right)
 
+	selectedCellStyle = cellStyle.
+				Background(styles.Secondary).
+				Foreground(styles.Background).
+				Bold(true)
+
+	panelTitleStyle = lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+
+	dayOffHeaderStyle = headerStyle.Foreground(styles.Tertiary).Italic(true)
+	dayOffStyle       = lipgloss.NewStyle().Foreground(styles.Tertiary)
+
 	totalColStyle = lipgloss.NewStyle().
 			Padding(0, 1).
 			Width(ColumnWidth).
@@ -48,86 +74,91 @@ var (
 )
 
 func New(cfg *config.Config) Model {
-	today := time.Now()
-	weekday := int(today.Weekday())
-	startOfWeek := today.AddDate(0, 0, -weekday)
-
 	m := Model{
 		config:    cfg,
 		entries:   []models.Entry{},
-		weekStart: startOfWeek,
+		weekStart: cfg.Schedule.StartOfWeek(time.Now()),
+		now:       time.Now,
 		ready:     false,
 	}
 
 	m.table = table.New().
-		BorderStyle(lipgloss.NewStyle().Foreground(styles.Secondary)).
-		StyleFunc(func(row, col int) lipgloss.Style {
-			numCols := 7 // Project + 5 days + Total
-			if row == table.HeaderRow {
-				if col == 0 {
-					return headerStyle.Width(m.projectColWidth)
-				}
-				if col == numCols-1 {
-					// Last column is always the Total col
-					return headerStyle.Foreground(styles.Secondary)
-				}
-				return headerStyle
-			}
-			// Last column is the Totals column
-			if col == numCols-1 {
-				return totalColStyle
-			}
-			style := cellStyle
-			if row%2 == 0 {
-				return style.Foreground(styles.Muted)
-			}
-			if col == 0 {
-				style.Width(m.projectColWidth).Align(lipgloss.Left)
-			}
-
-			return style
-		})
+		BorderStyle(lipgloss.NewStyle().Foreground(styles.Secondary))
+	m.col = m.initialCol()
 
 	return m
 }
 
+// Init fetches the displayed week, which stays put when switching views
 func (m Model) Init() tea.Cmd {
-	today := time.Now()
-	startOfWeek := today.AddDate(0, 0, -int(today.Weekday()))
-	return api.FetchEntriesForWeek(
-		m.config.APIKey,
-		m.config.WorkspaceId,
-		m.config.UserId,
-		startOfWeek,
-	)
+	return m.fetch(context.Background())
 }
 
 func (m *Model) SetSize(width, height int) {
 	m.width = width
 	m.height = height
+}
+
+// projectColWidth fills the width the day and total columns leave over
+func (m Model) projectColWidth(days int) int {
 	frameWidth, _ := TableStyle.GetFrameSize()
-	m.projectColWidth = width - frameWidth - (ColumnWidth * 6) - 2
+	return m.width - frameWidth - (ColumnWidth * (days + 1)) - 2
 }
 
 func (m *Model) PreviousWeek() tea.Cmd {
-	m.weekStart = m.weekStart.AddDate(0, 0, -7)
-	m.ready = false
-	return api.FetchEntriesForWeek(
-		m.config.APIKey,
-		m.config.WorkspaceId,
-		m.config.UserId,
-		m.weekStart,
-	)
+	return m.showWeek(m.weekStart.AddDate(0, 0, -7))
 }
 
 func (m *Model) NextWeek() tea.Cmd {
-	m.weekStart = m.weekStart.AddDate(0, 0, 7)
+	return m.showWeek(m.weekStart.AddDate(0, 0, 7))
+}
+
+// Refresh fetches the displayed week again, after an entry was saved or deleted
+func (m *Model) Refresh() tea.Cmd {
+	return m.fetchWeek()
+}
+
+func (m *Model) showWeek(weekStart time.Time) tea.Cmd {
+	m.weekStart = weekStart
+	m.daysOff = nil
+	m.entryCursor = 0
+	m.panelFocused = false
 	m.ready = false
-	return api.FetchEntriesForWeek(
-		m.config.APIKey,
-		m.config.WorkspaceId,
-		m.config.UserId,
-		m.weekStart,
+	return m.fetchWeek()
+}
+
+// fetchWeek fetches the displayed week, cancelling any fetch still in flight
+func (m *Model) fetchWeek() tea.Cmd {
+	if m.cancelFetch != nil {
+		m.cancelFetch()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelFetch = cancel
+
+	return m.fetch(ctx)
+}
+
+// fetch loads the week's entries and its days off
+func (m Model) fetch(ctx context.Context) tea.Cmd {
+	start, end := api.WeekRange(m.weekStart)
+	return tea.Batch(
+		api.FetchEntriesForWeek(
+			ctx,
+			m.config.APIKey,
+			m.config.WorkspaceId,
+			m.config.UserId,
+			m.weekStart,
+		),
+		api.FetchDaysOff(
+			ctx,
+			m.config.APIKey,
+			m.config.WorkspaceId,
+			m.config.UserId,
+			m.config.Schedule,
+			start,
+			end,
+		),
 	)
 }
 
@@ -136,35 +167,398 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.panelFocused {
+			cmds = append(cmds, m.updatePanel(msg))
+			break
+		}
+
+		rows := m.rows()
 		switch msg.String() {
-		case "h", "left":
+		case "[", "H":
 			cmds = append(cmds, m.PreviousWeek())
-		case "l", "right":
+		case "]", "L":
 			cmds = append(cmds, m.NextWeek())
+		case "t":
+			cmds = append(cmds, m.showWeek(m.config.Schedule.StartOfWeek(m.now())))
+			m.col = m.initialCol()
+		case "h", "left":
+			if m.col > 0 {
+				m.col--
+				m.entryCursor = 0
+			}
+		case "l", "right":
+			if m.col < len(m.days())-1 {
+				m.col++
+				m.entryCursor = 0
+			}
+		case "k", "up":
+			if m.row > 0 {
+				m.row--
+				m.entryCursor = 0
+			}
+		case "j", "down":
+			if m.row < len(rows)-1 {
+				m.row++
+				m.entryCursor = 0
+			}
+		case "enter", "tab":
+			if len(m.cellEntries()) > 0 {
+				m.panelFocused = true
+			}
+		case "n", "a":
+			// An empty week has no rows yet, the form asks for the project instead
+			projectID := ""
+			if m.row < len(rows) {
+				projectID = rows[m.row].projectID
+			}
+			cmds = append(cmds, m.newEntry(projectID))
+		case "e", "c", "d":
+			cmds = append(cmds, m.entryAction(msg.String()))
 		}
 
 	case messages.EntriesLoadedMsg:
-		m.entries = msg.Entries
+		// Drop responses for weeks we've already paged away from
+		if !msg.ForRange(api.WeekRange(m.weekStart)) {
+			break
+		}
+		m.entries = m.inWeek(msg.Entries)
 		m.table.ClearRows()
 		m.table.Headers(m.tableHeaders()...)
 		m.table.Rows(m.setTableData()...)
 		m.ready = true
 
+		// Keep the selection inside the grid when rows and days come and go
+		m.row = max(0, min(m.row, len(m.rows())-1))
+		m.col = max(0, min(m.col, len(m.days())-1))
+		m.entryCursor = max(0, min(m.entryCursor, len(m.cellEntries())-1))
+		if len(m.cellEntries()) == 0 {
+			m.panelFocused = false
+		}
+
+	case messages.DaysOffLoadedMsg:
+		if !msg.ForRange(api.WeekRange(m.weekStart)) {
+			break
+		}
+		m.daysOff = holidays.NewCalendar(msg.Days)
+		if m.ready {
+			m.table.ClearRows()
+			m.table.Rows(m.setTableData()...)
+		}
+
 	case messages.ProjectsLoadedMsg:
 		m.projects = msg.Projects
+		if m.ready {
+			m.table.ClearRows()
+			m.table.Rows(m.setTableData()...)
+		}
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
+// updatePanel moves through the selected cell's entries
+func (m *Model) updatePanel(msg tea.KeyPressMsg) tea.Cmd {
+	entries := m.cellEntries()
+	switch msg.String() {
+	case "esc", "tab", "h", "left":
+		m.panelFocused = false
+	case "k", "up":
+		if m.entryCursor > 0 {
+			m.entryCursor--
+		}
+	case "j", "down":
+		if m.entryCursor < len(entries)-1 {
+			m.entryCursor++
+		}
+	case "e", "enter":
+		return m.entryAction("e")
+	case "c", "d":
+		return m.entryAction(msg.String())
+	case "n", "a":
+		return m.newEntry(m.rows()[m.row].projectID)
+	}
+	return nil
+}
+
+// entryAction opens the edit, copy or delete flow for the panel's selected entry
+func (m Model) entryAction(key string) tea.Cmd {
+	entries := m.cellEntries()
+	if m.entryCursor >= len(entries) {
+		return nil
+	}
+
+	entry := entries[m.entryCursor]
+	switch key {
+	case "e":
+		return func() tea.Msg {
+			return messages.EntryUpdateStartedMsg{Entry: entry}
+		}
+	case "c":
+		return func() tea.Msg {
+			return messages.EntryCopyStartedMsg{Entry: entry}
+		}
+	case "d":
+		return func() tea.Msg {
+			return messages.EntryDeleteStartedMsg{EntryId: entry.ID}
+		}
+	}
+	return nil
+}
+
+// newEntry opens a new entry for the project on the selected day, up to an hour
+// in the free time nearest to where that day's last entry ends, or to 9am on an
+// empty day. Running and overnight entries count as busy, and the slot never
+// runs past midnight.
+func (m Model) newEntry(projectID string) tea.Cmd {
+	day := m.day(m.col)
+	dayEnd := day.AddDate(0, 0, 1)
+
+	// The day's share of each entry, with an empty one at midnight so the gaps start there
+	spans := []models.Entry{{ID: "midnight", TimeInterval: models.IntervalTime{Start: day, End: day}}}
+	at := day.Add(9 * time.Hour)
+	for _, entry := range m.entries {
+		if entry.DurationOn(day) == 0 {
+			continue
+		}
+		start := maxTime(entry.TimeInterval.Start, day)
+		end := minTime(entry.TimeInterval.Start.Add(entry.Duration()), dayEnd)
+		spans = append(spans, models.Entry{ID: entry.ID, TimeInterval: models.IntervalTime{Start: start, End: end}})
+		if len(spans) == 2 || end.After(at) {
+			at = end
+		}
+	}
+
+	start, end := at, at.Add(time.Hour)
+	if gap, ok := utils.NearestGap(utils.Gaps(spans, "", dayEnd), at); ok {
+		switch {
+		case at.Before(gap.Start):
+			start = gap.Start
+		case !at.Before(gap.End):
+			start = maxTime(gap.Start, gap.End.Add(-time.Hour))
+		}
+		end = minTime(start.Add(time.Hour), gap.End)
+	}
+	start, end = start.In(time.Local), end.In(time.Local)
+
+	return func() tea.Msg {
+		return messages.EntryCreateStartedMsg{
+			Start:     start,
+			End:       end,
+			ProjectID: projectID,
+		}
+	}
+}
+
+// days returns the midnights of the week's columns: the schedule's working
+// days, and any day off that has time logged on it
+func (m Model) days() []time.Time {
+	var days []time.Time
+	for i := range 7 {
+		day := m.weekStart.AddDate(0, 0, i)
+		if m.config.Schedule.IsWorkingDay(day.Weekday()) || m.hasTimeOn(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+func (m Model) hasTimeOn(day time.Time) bool {
+	for _, entry := range m.entries {
+		if entry.DurationOn(day) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// day returns midnight of the day in column col
+func (m Model) day(col int) time.Time {
+	days := m.days()
+	if len(days) == 0 {
+		return m.weekStart
+	}
+	return days[max(0, min(col, len(days)-1))]
+}
+
+// initialCol selects today when it's one of the displayed week's columns
+func (m Model) initialCol() int {
+	today := m.now().In(time.Local)
+	for col, day := range m.days() {
+		if sameDay(day, today) {
+			return col
+		}
+	}
+	return 0
+}
+
+// rows groups the week's entries by project, sorted by name so the
+// selection stays put between reloads
+func (m Model) rows() []row {
+	var rows []row
+	for projectID, entries := range groupEntriesByProject(m.entries) {
+		rows = append(rows, row{
+			projectID: projectID,
+			name:      m.projectName(projectID),
+			entries:   entries,
+		})
+	}
+	slices.SortFunc(rows, func(a, b row) int {
+		if c := strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.projectID, b.projectID)
+	})
+	return rows
+}
+
+// cellEntries returns the entries behind the selected cell, in start order
+func (m Model) cellEntries() []models.Entry {
+	rows := m.rows()
+	if m.row >= len(rows) {
+		return nil
+	}
+
+	day := m.day(m.col)
+	var entries []models.Entry
+	for _, entry := range rows[m.row].entries {
+		if entry.DurationOn(day) > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	slices.SortFunc(entries, func(a, b models.Entry) int {
+		return a.TimeInterval.Start.Compare(b.TimeInterval.Start)
+	})
+	return entries
+}
+
+func (m Model) projectName(projectID string) string {
+	project, err := utils.FindProjectById(m.projects, projectID)
+	if err != nil {
+		return "(No Project)"
+	}
+	if project.ClientName != "" {
+		return fmt.Sprintf("%s (%s)", project.Name, project.ClientName)
+	}
+	return project.Name
+}
+
 func (m Model) View() tea.View {
-	return tea.NewView(TableStyle.Render(m.table.Render()))
+	m.table.StyleFunc(m.cellStyle)
+
+	var sb strings.Builder
+	sb.WriteString(m.table.Render())
+	if daysOff := m.renderDaysOff(); daysOff != "" {
+		sb.WriteString("\n" + daysOff)
+	}
+	sb.WriteString("\n\n" + m.renderPanel())
+	sb.WriteString("\n\n" + styles.HelpStyle.Render("h/j/k/l select · [/] week · t this week · enter entries · n add time · e edit · c copy · d delete"))
+
+	return tea.NewView(TableStyle.Render(sb.String()))
+}
+
+// cellStyle styles the table, highlighting the selected cell
+func (m Model) cellStyle(row, col int) lipgloss.Style {
+	days := len(m.days())
+	numCols := days + 2 // Project + days + Total
+	if row == table.HeaderRow {
+		if col == 0 {
+			return headerStyle.Width(m.projectColWidth(days))
+		}
+		if col == numCols-1 {
+			// Last column is always the Total col
+			return headerStyle.Foreground(styles.Secondary)
+		}
+		if _, off := m.daysOff.On(m.day(col - 1)); off {
+			return dayOffHeaderStyle
+		}
+		return headerStyle
+	}
+	if row == m.row && col == m.col+1 && row < len(m.rows()) {
+		return selectedCellStyle
+	}
+	// Last column is the Totals column
+	if col == numCols-1 {
+		return totalColStyle
+	}
+	style := cellStyle
+	if row%2 == 0 {
+		return style.Foreground(styles.Muted)
+	}
+	if col == 0 {
+		style.Width(m.projectColWidth(days)).Align(lipgloss.Left)
+	}
+
+	return style
+}
+
+// renderDaysOff names the week's holidays and leave, which the headers mark
+func (m Model) renderDaysOff() string {
+	var names []string
+	for _, off := range m.daysOff.Between(m.weekStart, m.weekStart.AddDate(0, 0, 7)) {
+		name := off.Date.Format("Mon 01/02") + " " + off.Name
+		if off.Half {
+			name += " (half day)"
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return dayOffStyle.Render("Days off: " + strings.Join(names, " · "))
+}
+
+// renderPanel lists the entries behind the selected cell
+func (m Model) renderPanel() string {
+	rows := m.rows()
+	if !m.ready || len(rows) == 0 {
+		return styles.MutedTextStyle.Render("No entries this week · press t for this week")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(panelTitleStyle.Render(fmt.Sprintf("%s · %s", m.day(m.col).Format("Mon 01/02"), rows[m.row].name)))
+	if off, ok := m.daysOff.On(m.day(m.col)); ok {
+		sb.WriteString(dayOffStyle.Render(" · " + off.Name))
+	}
+	sb.WriteString("\n")
+
+	entries := m.cellEntries()
+	if len(entries) == 0 {
+		sb.WriteString(styles.MutedTextStyle.Render("No time logged · press n to add some"))
+		return sb.String()
+	}
+
+	for i, entry := range entries {
+		cursor := "  "
+		if m.panelFocused && i == m.entryCursor {
+			cursor = styles.SelectedItemStyle.UnsetPadding().Render("❯ ")
+		}
+
+		end := "running"
+		if !entry.IsRunning() {
+			end = entry.TimeInterval.End.In(time.Local).Format("15:04")
+		}
+		description := entry.Description
+		if description == "" {
+			description = "(No Description)"
+		}
+
+		line := fmt.Sprintf("%s–%-7s  %8s  %s",
+			entry.TimeInterval.Start.In(time.Local).Format("15:04"),
+			end,
+			formatDuration(entry.DurationOn(m.day(m.col))),
+			description,
+		)
+		if m.panelFocused && i == m.entryCursor {
+			line = styles.SelectedItemStyle.UnsetPadding().Render(line)
+		}
+		sb.WriteString(cursor + line + "\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
 }
 
 func (m Model) tableHeaders() []string {
 	headers := []string{"Project"}
-	for i := range 5 {
-		day := m.weekStart.AddDate(0, 0, i+1)
+	for _, day := range m.days() {
 		headers = append(headers, day.Format("Mon 01/02"))
 	}
 	headers = append(headers, "Total")
@@ -173,58 +567,87 @@ func (m Model) tableHeaders() []string {
 
 func (m Model) setTableData() [][]string {
 	rows := [][]string{}
-	groupedEntries := groupEntriesByProject(m.entries)
-	startOfWeek := m.weekStart
+	days := m.days()
 	dailyTotals := make(map[string]time.Duration)
+	billableTotals := make(map[string]time.Duration)
 
-	for _, group := range groupedEntries {
-		project, _ := utils.FindProjectById(m.projects, group[0].ProjectID)
-
-		projectName := project.Name
-		if project.ClientName != "" {
-			projectName = fmt.Sprintf("%s (%s)", project.Name, project.ClientName)
-		}
-
-		row := []string{projectName}
+	for _, group := range m.rows() {
+		cells := []string{group.name}
 		var totalDuration time.Duration
 
-		for i := range 5 {
-			day := startOfWeek.AddDate(0, 0, i+1)
+		for _, day := range days {
 			var dayDuration time.Duration
 
-			for _, entry := range group {
-				entryDate := entry.TimeInterval.Start
-				if entryDate.Year() == day.Year() &&
-					entryDate.Month() == day.Month() &&
-					entryDate.Day() == day.Day() {
-					d := strings.TrimPrefix(entry.TimeInterval.Duration, "PT")
-					entryDuration, _ := time.ParseDuration(strings.ToLower(d))
-					dayDuration += entryDuration
-					totalDuration += entryDuration
+			for _, entry := range group.entries {
+				// Overnight entries count towards each day they cover
+				entryDuration := entry.DurationOn(day)
+				if entryDuration == 0 {
+					continue
+				}
+				dayDuration += entryDuration
+				totalDuration += entryDuration
+				if entry.Billable {
+					billableTotals[day.Format("2006-01-02")] += entryDuration
+					billableTotals["total"] += entryDuration
 				}
 			}
 
 			dailyTotals[day.Format("2006-01-02")] += dayDuration
-			row = append(row, formatDuration(dayDuration))
+			cells = append(cells, formatDuration(dayDuration))
 		}
 
 		dailyTotals["total"] += totalDuration
-		row = append(row, formatDuration(totalDuration))
-		rows = append(rows, row)
+		cells = append(cells, formatDuration(totalDuration))
+		rows = append(rows, cells)
 	}
 
 	// Totals row
 	totalsRow := []string{"Totals"}
-	for i := range 5 {
-		day := startOfWeek.AddDate(0, 0, i+1)
+	for _, day := range days {
 		totalsRow = append(totalsRow, formatDuration(dailyTotals[day.Format("2006-01-02")]))
 	}
 	totalsRow = append(totalsRow, formatDuration(dailyTotals["total"]))
 	rows = append(rows, totalsRow)
 
+	// Billable split of the totals
+	billableRow := []string{"Billable"}
+	nonBillableRow := []string{"Non-billable"}
+	for _, day := range days {
+		key := day.Format("2006-01-02")
+		billableRow = append(billableRow, formatDuration(billableTotals[key]))
+		nonBillableRow = append(nonBillableRow, formatDuration(dailyTotals[key]-billableTotals[key]))
+	}
+	billableRow = append(billableRow, formatDuration(billableTotals["total"]))
+	nonBillableRow = append(nonBillableRow, formatDuration(dailyTotals["total"]-billableTotals["total"]))
+	rows = append(rows, billableRow, nonBillableRow)
+
+	// What the schedule expects less holidays and leave, for the whole week even when days off are hidden
+	schedule := m.config.Schedule
+	targetRow := []string{"Target"}
+	for _, day := range days {
+		targetRow = append(targetRow, formatDuration(m.daysOff.Target(schedule, day)))
+	}
+	targetRow = append(targetRow, formatDuration(m.daysOff.TargetBetween(schedule, m.weekStart, m.weekStart.AddDate(0, 0, 7))))
+	rows = append(rows, targetRow)
+
 	return rows
 }
 
+// inWeek drops the entries fetched from the day before the week that end before it starts
+func (m Model) inWeek(entries []models.Entry) []models.Entry {
+	end := m.weekStart.AddDate(0, 0, 7)
+	var kept []models.Entry
+	for _, entry := range entries {
+		for _, day := range entry.Days(time.Local) {
+			if !day.Before(m.weekStart) && day.Before(end) {
+				kept = append(kept, entry)
+				break
+			}
+		}
+	}
+	return kept
+}
+
 func groupEntriesByProject(entries []models.Entry) map[string][]models.Entry {
 	projectMap := make(map[string][]models.Entry)
 	for _, entry := range entries {
@@ -244,3 +667,21 @@ func formatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%dh %dm", h, min)
 }
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,282 @@
+package week
+
+import (
+	"clockify-app/internal/api"
+	"clockify-app/internal/config"
+	"clockify-app/internal/messages"
+	"clockify-app/internal/models"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// Sunday, October 11 starts the test week
+var weekStart = time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local)
+
+func at(day, hour, min int) time.Time {
+	return time.Date(2026, 10, day, hour, min, 0, 0, time.Local)
+}
+
+func entryAt(id, projectID string, start time.Time, length time.Duration) models.Entry {
+	return models.Entry{
+		ID:          id,
+		ProjectID:   projectID,
+		Description: "Work " + id,
+		TimeInterval: models.IntervalTime{
+			Start: start,
+			End:   start.Add(length),
+		},
+	}
+}
+
+// loaded returns the test week on Wednesday, October 14 with the given entries
+func loaded(entries ...models.Entry) Model {
+	m := New(&config.Config{})
+	m.now = func() time.Time { return at(14, 12, 0) }
+	m.weekStart = weekStart
+	m.col = m.initialCol()
+	m, _ = m.Update(messages.ProjectsLoadedMsg{Projects: []models.Project{
+		{ID: "web", Name: "Website"},
+		{ID: "api", Name: "API"},
+	}})
+
+	start, end := api.WeekRange(m.weekStart)
+	m, _ = m.Update(messages.EntriesLoadedMsg{Entries: entries, Start: start, End: end})
+	return m
+}
+
+func press(m Model, key rune) (Model, tea.Cmd) {
+	return m.Update(tea.KeyPressMsg{Code: key, Text: string(key)})
+}
+
+func run(cmd tea.Cmd) tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	return cmd()
+}
+
+func TestGridNavigation(t *testing.T) {
+	m := loaded(
+		entryAt("w1", "web", at(14, 9, 0), time.Hour),
+		entryAt("a1", "api", at(12, 9, 0), 2*time.Hour),
+	)
+
+	if m.col != 2 {
+		t.Errorf("Expected Wednesday to be selected, got column %d", m.col)
+	}
+
+	// Rows are sorted by project name
+	if rows := m.rows(); rows[0].projectID != "api" || rows[1].projectID != "web" {
+		t.Fatalf("Expected API before Website, got %+v", rows)
+	}
+
+	m, _ = press(m, 'j')
+	if entries := m.cellEntries(); len(entries) != 1 || entries[0].ID != "w1" {
+		t.Errorf("Expected Website's Wednesday entry, got %v", entries)
+	}
+
+	m, _ = press(m, 'k')
+	m, _ = press(m, 'h')
+	m, _ = press(m, 'h')
+	if entries := m.cellEntries(); len(entries) != 1 || entries[0].ID != "a1" {
+		t.Errorf("Expected API's Monday entry, got %v", entries)
+	}
+
+	// The grid stops at its edges
+	m, _ = press(m, 'h')
+	m, _ = press(m, 'k')
+	if m.col != 0 || m.row != 0 {
+		t.Errorf("Expected the selection to stay at Monday/API, got %d/%d", m.row, m.col)
+	}
+
+	if !strings.Contains(m.View().Content, "Mon 10/12 · API") {
+		t.Error("Expected the panel to show the selected cell")
+	}
+}
+
+func TestPanelActions(t *testing.T) {
+	m := loaded(
+		entryAt("late", "web", at(14, 13, 0), time.Hour),
+		entryAt("early", "web", at(14, 9, 0), time.Hour),
+	)
+
+	// Actions use the first entry until the panel is browsed
+	if msg, ok := run(m.entryAction("e")).(messages.EntryUpdateStartedMsg); !ok || msg.Entry.ID != "early" {
+		t.Errorf("Expected the earliest entry to be edited, got %#v", msg)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !m.panelFocused {
+		t.Fatal("Expected enter to focus the panel")
+	}
+	m, _ = press(m, 'j')
+
+	_, cmd := press(m, 'c')
+	if msg, ok := run(cmd).(messages.EntryCopyStartedMsg); !ok || msg.Entry.ID != "late" {
+		t.Errorf("Expected the later entry to be copied, got %#v", msg)
+	}
+	_, cmd = press(m, 'd')
+	if msg, ok := run(cmd).(messages.EntryDeleteStartedMsg); !ok || msg.EntryId != "late" {
+		t.Errorf("Expected the later entry to be deleted, got %#v", msg)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.panelFocused {
+		t.Error("Expected esc to return to the grid")
+	}
+}
+
+func TestAddTimeToCell(t *testing.T) {
+	m := loaded(
+		entryAt("w1", "web", at(14, 9, 0), time.Hour),
+		entryAt("a1", "api", at(14, 10, 0), 90*time.Minute),
+	)
+	m, _ = press(m, 'j') // Website
+
+	_, cmd := press(m, 'n')
+	msg, ok := run(cmd).(messages.EntryCreateStartedMsg)
+	if !ok {
+		t.Fatalf("Expected a new entry, got %#v", msg)
+	}
+	if msg.ProjectID != "web" {
+		t.Errorf("Expected the Website project, got %q", msg.ProjectID)
+	}
+	// New time goes after the day's last entry, whatever its project
+	if !msg.Start.Equal(at(14, 11, 30)) || !msg.End.Equal(at(14, 12, 30)) {
+		t.Errorf("Expected 11:30-12:30, got %v - %v", msg.Start, msg.End)
+	}
+
+	// Empty days start at 9am
+	m, _ = press(m, 'l')
+	msg, _ = run(m.newEntry("web")).(messages.EntryCreateStartedMsg)
+	if !msg.Start.Equal(at(15, 9, 0)) {
+		t.Errorf("Expected 09:00 on Thursday, got %v", msg.Start)
+	}
+}
+
+func TestAddTimeFindsFreeTime(t *testing.T) {
+	late := entryAt("late", "web", at(14, 22, 0), 90*time.Minute)
+	m := loaded(
+		entryAt("night", "web", at(13, 23, 0), 10*time.Hour), // Until 9am Wednesday
+		late,
+	)
+
+	// The slot ends at midnight rather than running into Thursday
+	msg, _ := run(m.newEntry("web")).(messages.EntryCreateStartedMsg)
+	if !msg.Start.Equal(at(14, 23, 30)) || !msg.End.Equal(at(15, 0, 0)) {
+		t.Errorf("Expected 23:30 up to midnight, got %v - %v", msg.Start, msg.End)
+	}
+
+	// A full evening moves the slot to the nearest free hour before it
+	late.TimeInterval.Start = at(14, 20, 0)
+	late.TimeInterval.End = at(15, 0, 0)
+	m = loaded(entryAt("night", "web", at(13, 23, 0), 10*time.Hour), late)
+	msg, _ = run(m.newEntry("web")).(messages.EntryCreateStartedMsg)
+	if !msg.Start.Equal(at(14, 19, 0)) || !msg.End.Equal(at(14, 20, 0)) {
+		t.Errorf("Expected 19:00-20:00, got %v - %v", msg.Start, msg.End)
+	}
+
+	// An overnight entry from the day before keeps the slot clear of its morning
+	m = loaded(entryAt("night", "web", at(13, 23, 0), 10*time.Hour))
+	msg, _ = run(m.newEntry("web")).(messages.EntryCreateStartedMsg)
+	if !msg.Start.Equal(at(14, 9, 0)) {
+		t.Errorf("Expected 09:00 after the overnight entry, got %v", msg.Start)
+	}
+}
+
+func TestAddTimeToEmptyWeek(t *testing.T) {
+	m := loaded()
+
+	_, cmd := press(m, 'n')
+	msg, ok := run(cmd).(messages.EntryCreateStartedMsg)
+	if !ok {
+		t.Fatalf("Expected a new entry on an empty week, got %#v", msg)
+	}
+	if msg.ProjectID != "" || !msg.Start.Equal(at(14, 9, 0)) {
+		t.Errorf("Expected 09:00 on Wednesday without a project, got %q at %v", msg.ProjectID, msg.Start)
+	}
+}
+
+func TestPagingKeepsSelectionInGrid(t *testing.T) {
+	m := loaded(
+		entryAt("w1", "web", at(14, 9, 0), time.Hour),
+		entryAt("a1", "api", at(14, 10, 0), time.Hour),
+	)
+	m, _ = press(m, 'j')
+
+	m, _ = press(m, ']')
+	if !m.weekStart.Equal(weekStart.AddDate(0, 0, 7)) || m.ready {
+		t.Errorf("Expected the next week to load, got %v", m.weekStart)
+	}
+
+	// The next week has a single project
+	start, end := api.WeekRange(m.weekStart)
+	m, _ = m.Update(messages.EntriesLoadedMsg{
+		Entries: []models.Entry{entryAt("a2", "api", at(21, 9, 0), time.Hour)},
+		Start:   start,
+		End:     end,
+	})
+	if m.row != 0 {
+		t.Errorf("Expected the selection to move back into the grid, got row %d", m.row)
+	}
+}
+
+func TestOvernightIntoWeek(t *testing.T) {
+	m := loaded(
+		entryAt("late", "web", at(10, 22, 0), 4*time.Hour),
+		entryAt("before", "api", at(10, 9, 0), time.Hour),
+	)
+
+	// Only the part after midnight counts, and entries that end before the week are left out
+	if rows := m.rows(); len(rows) != 1 || rows[0].projectID != "web" {
+		t.Fatalf("Expected only the overnight entry's project, got %+v", rows)
+	}
+	if days := m.days(); !days[0].Equal(weekStart) {
+		t.Errorf("Expected Sunday to show for the time logged on it, got %v", days)
+	}
+	if cells := m.setTableData()[0]; cells[1] != "2h 0m" {
+		t.Errorf("Expected 2h on Sunday, got %v", cells)
+	}
+}
+
+func TestDaysOffLowerTargets(t *testing.T) {
+	m := loaded(entryAt("w1", "web", at(14, 9, 0), time.Hour))
+	start, end := api.WeekRange(m.weekStart)
+
+	// Days off for another week are dropped
+	m, _ = m.Update(messages.DaysOffLoadedMsg{
+		Days:  []models.DayOff{{Date: at(5, 0, 0), Name: "Elsewhere"}},
+		Start: start.AddDate(0, 0, -7),
+		End:   end.AddDate(0, 0, -7),
+	})
+	if len(m.daysOff) != 0 {
+		t.Fatalf("Expected days off for another week to be ignored, got %v", m.daysOff)
+	}
+
+	m, _ = m.Update(messages.DaysOffLoadedMsg{
+		Days: []models.DayOff{
+			{Date: at(12, 0, 0), Name: "Columbus Day"},
+			{Date: at(16, 0, 0), Name: "Leave", Half: true},
+		},
+		Start: start,
+		End:   end,
+	})
+
+	rows := m.setTableData()
+	target := rows[len(rows)-1]
+	if target[1] != "-" || target[5] != "4h 0m" || target[6] != "28h 0m" {
+		t.Errorf("Expected Monday off, half of Friday and 28h for the week, got %v", target)
+	}
+	if legend := m.renderDaysOff(); !strings.Contains(legend, "Mon 10/12 Columbus Day") || !strings.Contains(legend, "(half day)") {
+		t.Errorf("Expected the days off to be listed, got %q", legend)
+	}
+
+	// Paging forgets the week's days off until the next week's arrive
+	m, _ = press(m, ']')
+	if len(m.daysOff) != 0 {
+		t.Errorf("Expected paging to clear the days off, got %v", m.daysOff)
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"clockify-app/internal/models"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +18,68 @@ func FindProjectById(projects []models.Project, id string) (models.Project, erro
 	return models.Project{}, strconv.ErrSyntax
 }
 
+// FindProjectByNameOrId finds a project by exact ID or case-insensitive name.
+// An error is returned when nothing matches or the name is ambiguous.
+func FindProjectByNameOrId(projects []models.Project, query string) (models.Project, error) {
+	if project, err := FindProjectById(projects, query); err == nil {
+		return project, nil
+	}
+
+	var matches []models.Project
+	for _, proj := range projects {
+		if strings.EqualFold(proj.Name, strings.TrimSpace(query)) {
+			matches = append(matches, proj)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.Project{}, fmt.Errorf("no project matches %q", query)
+	case 1:
+		return matches[0], nil
+	default:
+		return models.Project{}, fmt.Errorf("%d projects named %q, use the project ID instead", len(matches), query)
+	}
+}
+
+// FindTaskByNameOrId finds a task by exact ID or case-insensitive name.
+// An error is returned when nothing matches or the name is ambiguous.
+func FindTaskByNameOrId(tasks []models.Task, query string) (models.Task, error) {
+	var matches []models.Task
+	for _, task := range tasks {
+		if task.ID == query {
+			return task, nil
+		}
+		if strings.EqualFold(task.Name, strings.TrimSpace(query)) {
+			matches = append(matches, task)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.Task{}, fmt.Errorf("no task matches %q", query)
+	case 1:
+		return matches[0], nil
+	default:
+		return models.Task{}, fmt.Errorf("%d tasks named %q, use the task ID instead", len(matches), query)
+	}
+}
+
+// TagNames returns the names of the given tag IDs, in the same order
+// IDs that aren't in tags are skipped
+func TagNames(tags []models.Tag, ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		for _, tag := range tags {
+			if tag.ID == id {
+				names = append(names, tag.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
 func FindEntryById(entries []models.Entry, id string) (models.Entry, error) {
 	for _, entry := range entries {
 		if entry.ID == id {
@@ -26,46 +89,233 @@ func FindEntryById(entries []models.Entry, id string) (models.Entry, error) {
 	return models.Entry{}, strconv.ErrSyntax
 }
 
-// parseTime converts a time string like "9a" or "3:30p" to a full time.Time
-// It handles various formats: 9a, 9:30a, 9, 9:30
+// now is replaced in tests
+var now = time.Now
+
+// durationPattern matches hour and minute durations like 1h30, 1h30m, 1.5h and 90m
+var durationPattern = regexp.MustCompile(`^(?:(\d+(?:\.\d+)?)h(?:(\d+)m?)?|(\d+)m(?:in)?)$`)
+
+// ParseTime converts a time string like "9a" or "3:30p" to a full time.Time on date
+// It handles 9a, 9:30a, 930a, 9, 9:30, 0930, 14:30, noon, midnight,
+// and now with an optional offset like now-15m or now+1h.
 func ParseTime(timeStr string, date time.Time) (time.Time, error) {
 	// Normalize the string: lowercase, remove spaces
-	timeStr = strings.ToLower(strings.TrimSpace(timeStr))
-	timeStr = strings.ReplaceAll(timeStr, " ", "")
+	input := strings.ToLower(strings.TrimSpace(timeStr))
+	input = strings.ReplaceAll(input, " ", "")
+	invalid := fmt.Errorf("Invalid time format: \"%s\"", strings.TrimSpace(timeStr))
 
-	var hour, minute int
+	switch input {
+	case "noon":
+		return atClock(date, 12, 0), nil
+	case "midnight":
+		return atClock(date, 0, 0), nil
+	}
 
-	// Check if PM (afternoon/evening)
-	isPM := strings.HasSuffix(timeStr, "p") || strings.HasSuffix(timeStr, "pm")
+	if offset, ok := strings.CutPrefix(input, "now"); ok {
+		current := now().In(date.Location())
+		result := atClock(date, current.Hour(), current.Minute())
+		if offset == "" {
+			return result, nil
+		}
+
+		sign := offset[0]
+		d, err := ParseDuration(offset[1:])
+		if err != nil || (sign != '+' && sign != '-') {
+			return time.Time{}, invalid
+		}
+		if sign == '-' {
+			d = -d
+		}
+		return result.Add(d), nil
+	}
 
-	// Remove the am/pm suffix
-	timeStr = strings.TrimSuffix(strings.TrimSuffix(timeStr, "p"), "m")
-	timeStr = strings.TrimSuffix(strings.TrimSuffix(timeStr, "a"), "m")
+	hour, minute, ok := parseClock(input)
+	if !ok {
+		return time.Time{}, invalid
+	}
+	return atClock(date, hour, minute), nil
+}
 
-	// Parse hour and optional minutes
-	var n int
-	var err error
-	if strings.Contains(timeStr, ":") {
-		n, err = fmt.Sscanf(timeStr, "%d:%d", &hour, &minute)
-		if err != nil || n != 2 {
-			return time.Time{}, fmt.Errorf("Invalid time format: \"%s\"", timeStr)
+// parseClock reads a wall clock time in 12 or 24 hour form
+func parseClock(input string) (hour, minute int, ok bool) {
+	// Check for an am/pm suffix
+	meridiem := ""
+	for _, suffix := range []string{"am", "pm", "a", "p"} {
+		if rest, found := strings.CutSuffix(input, suffix); found {
+			meridiem = suffix[:1]
+			input = rest
+			break
 		}
-	} else {
-		n, err = fmt.Sscanf(timeStr, "%d", &hour)
-		if err != nil || n != 1 {
-			return time.Time{}, fmt.Errorf("Invalid time format: \"%s\"", timeStr)
+	}
+
+	// Split hours from minutes: 9:30, or the compact 930 and 0930
+	hourStr, minuteStr, hasColon := strings.Cut(input, ":")
+	if !hasColon {
+		switch len(input) {
+		case 1, 2:
+			hourStr, minuteStr = input, "00"
+		case 3, 4:
+			hourStr, minuteStr = input[:len(input)-2], input[len(input)-2:]
+		default:
+			return 0, 0, false
 		}
 	}
+	if len(hourStr) == 0 || len(hourStr) > 2 || len(minuteStr) != 2 ||
+		!isDigits(hourStr) || !isDigits(minuteStr) {
+		return 0, 0, false
+	}
 
-	// Convert to 24-hour format
-	if isPM && hour != 12 {
-		hour += 12 // 1pm = 13, 2pm = 14, etc.
-	} else if !isPM && hour == 12 {
-		hour = 0 // 12am = midnight = 0
+	hour, _ = strconv.Atoi(hourStr)
+	minute, _ = strconv.Atoi(minuteStr)
+	if minute > 59 {
+		return 0, 0, false
+	}
+
+	switch meridiem {
+	case "":
+		if hour > 23 {
+			return 0, 0, false
+		}
+	case "a", "p":
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		// Convert to 24-hour format: 12am is midnight, 1pm is 13
+		hour %= 12
+		if meridiem == "p" {
+			hour += 12
+		}
 	}
 
-	returnTime := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, date.Location())
+	return hour, minute, true
+}
 
-	return returnTime, nil
+// ParseDuration reads durations like 1h30, 1h30m, 1.5h, 45m or +2h, rounded to the minute
+func ParseDuration(input string) (time.Duration, error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	input = strings.TrimPrefix(strings.ReplaceAll(input, " ", ""), "+")
+
+	match := durationPattern.FindStringSubmatch(input)
+	if match == nil {
+		return 0, fmt.Errorf("Invalid duration: \"%s\"", input)
+	}
+
+	var d time.Duration
+	if match[1] != "" {
+		hours, _ := strconv.ParseFloat(match[1], 64)
+		d = time.Duration(hours * float64(time.Hour))
+	}
+	for _, minutes := range match[2:] {
+		if minutes != "" {
+			m, _ := strconv.Atoi(minutes)
+			d += time.Duration(m) * time.Minute
+		}
+	}
+
+	return d.Round(time.Minute), nil
+}
+
+// ParseEndTime reads an end time, which can also be a duration from start like +1h30 or 1.5h
+// Clock times are placed on start's date.
+func ParseEndTime(timeStr string, start time.Time) (time.Time, error) {
+	if d, err := ParseDuration(timeStr); err == nil {
+		return start.Add(d), nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(timeStr), "+") {
+		return time.Time{}, fmt.Errorf("Invalid duration: \"%s\"", strings.TrimSpace(timeStr))
+	}
+	return ParseTime(timeStr, start)
+}
+
+func atClock(date time.Time, hour, minute int) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, date.Location())
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// relativeDaysPattern matches day offsets like -2d, +1d or -1w
+var relativeDaysPattern = regexp.MustCompile(`^([+-])(\d+)([dw])$`)
+
+// ParseDate converts a date string to midnight on that date, relative to today
+// It handles today, yesterday, weekdays like fri or last fri, offsets like -2d or -1w,
+// 2026-10-03 and 10/3 or 10/3/2026.
+func ParseDate(dateStr string, today time.Time) (time.Time, error) {
+	input := strings.Join(strings.Fields(strings.ToLower(dateStr)), " ")
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	invalid := fmt.Errorf("Invalid date: \"%s\"", strings.TrimSpace(dateStr))
+
+	switch input {
+	case "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if match := relativeDaysPattern.FindStringSubmatch(input); match != nil {
+		n, _ := strconv.Atoi(match[2])
+		if match[3] == "w" {
+			n *= 7
+		}
+		if match[1] == "-" {
+			n = -n
+		}
+		return today.AddDate(0, 0, n), nil
+	}
+
+	// The most recent matching weekday, today included unless it's "last"
+	name, last := strings.CutPrefix(input, "last ")
+	if weekday, ok := parseWeekday(name); ok {
+		daysBack := (int(today.Weekday()) - int(weekday) + 7) % 7
+		if last && daysBack == 0 {
+			daysBack = 7
+		}
+		return today.AddDate(0, 0, -daysBack), nil
+	}
+	if last {
+		return time.Time{}, invalid
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", input, today.Location()); err == nil {
+		return date, nil
+	}
+
+	// Month and day, in the current year unless one is given
+	if strings.Count(input, "/") == 1 {
+		input = fmt.Sprintf("%s/%d", input, today.Year())
+	}
+	if date, err := time.ParseInLocation("1/2/2006", input, today.Location()); err == nil {
+		return date, nil
+	}
+
+	return time.Time{}, invalid
+}
+
+// parseWeekday reads a full or abbreviated weekday name
+func parseWeekday(name string) (time.Weekday, bool) {
+	if len(name) < 2 {
+		return 0, false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if strings.HasPrefix(full, name) {
+			return day, true
+		}
+	}
+	return 0, false
+}
 
+// FormatElapsed formats a duration as h:mm:ss, as shown for running timers
+func FormatElapsed(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := int(d.Hours())
+	min := int(d.Minutes()) % 60
+	sec := int(d.Seconds()) % 60
+	return fmt.Sprintf("%d:%02d:%02d", h, min, sec)
 }
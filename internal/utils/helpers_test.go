@@ -53,30 +53,123 @@ func TestFindProjectById(t *testing.T) {
 	}
 }
 
+func TestFindProjectByNameOrId(t *testing.T) {
+	projects := []models.Project{
+		{ID: "p1", Name: "Website"},
+		{ID: "p2", Name: "Internal"},
+		{ID: "p3", Name: "Meetings"},
+		{ID: "p4", Name: "meetings"},
+	}
+
+	// Test finding by ID
+	project, err := FindProjectByNameOrId(projects, "p2")
+	if err != nil || project.Name != "Internal" {
+		t.Errorf("Expected 'Internal' by ID, got %q (err: %v)", project.Name, err)
+	}
+
+	// Test finding by name, case insensitive
+	project, err = FindProjectByNameOrId(projects, "website")
+	if err != nil || project.ID != "p1" {
+		t.Errorf("Expected 'p1' by name, got %q (err: %v)", project.ID, err)
+	}
+
+	// Test ambiguous name
+	if _, err = FindProjectByNameOrId(projects, "Meetings"); err == nil {
+		t.Error("Expected error for ambiguous project name")
+	}
+
+	// Test no match
+	if _, err = FindProjectByNameOrId(projects, "Nope"); err == nil {
+		t.Error("Expected error for unknown project")
+	}
+}
+
+func TestFindTaskByNameOrId(t *testing.T) {
+	tasks := []models.Task{
+		{ID: "t1", Name: "Code Review"},
+		{ID: "t2", Name: "Planning"},
+	}
+
+	task, err := FindTaskByNameOrId(tasks, "t2")
+	if err != nil || task.Name != "Planning" {
+		t.Errorf("Expected 'Planning' by ID, got %q (err: %v)", task.Name, err)
+	}
+
+	task, err = FindTaskByNameOrId(tasks, "code review")
+	if err != nil || task.ID != "t1" {
+		t.Errorf("Expected 't1' by name, got %q (err: %v)", task.ID, err)
+	}
+
+	if _, err = FindTaskByNameOrId(tasks, "Nope"); err == nil {
+		t.Error("Expected error for unknown task")
+	}
+}
+
+func TestTagNames(t *testing.T) {
+	tags := []models.Tag{
+		{ID: "t1", Name: "Meeting"},
+		{ID: "t2", Name: "Development"},
+	}
+
+	names := TagNames(tags, []string{"t2", "missing", "t1"})
+	if len(names) != 2 || names[0] != "Development" || names[1] != "Meeting" {
+		t.Errorf("Expected [Development Meeting], got %v", names)
+	}
+
+	if names := TagNames(tags, nil); len(names) != 0 {
+		t.Errorf("Expected no names, got %v", names)
+	}
+}
+
 func TestParseTime(t *testing.T) {
 	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	now = func() time.Time { return time.Date(2026, 5, 6, 14, 20, 45, 0, time.Local) }
+	t.Cleanup(func() { now = time.Now })
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
 
 	happyTests := []struct {
 		input    string
 		expected time.Time
 	}{
-		{"9a", time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)},
-		{"3:30p", time.Date(2024, 1, 1, 15, 30, 0, 0, time.Local)},
-		{"12pm", time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local)},
-		{"12am", time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)},
-		{"7", time.Date(2024, 1, 1, 7, 0, 0, 0, time.Local)},
-		{"11:15", time.Date(2024, 1, 1, 11, 15, 0, 0, time.Local)},
-		{"4 PM", time.Date(2024, 1, 1, 16, 0, 0, 0, time.Local)},
+		{"9a", at(9, 0)},
+		{"3:30p", at(15, 30)},
+		{"12pm", at(12, 0)},
+		{"12am", at(0, 0)},
+		{"12a", at(0, 0)},
+		{"12p", at(12, 0)},
+		{"12:30a", at(0, 30)},
+		{"7", at(7, 0)},
+		{"11:15", at(11, 15)},
+		{"4 PM", at(16, 0)},
+		{"930a", at(9, 30)},
+		{"930", at(9, 30)},
+		{"0930", at(9, 30)},
+		{"1230p", at(12, 30)},
+		{"1430", at(14, 30)},
+		{"0", at(0, 0)},
+		{"23:59", at(23, 59)},
+		{"noon", at(12, 0)},
+		{"Midnight", at(0, 0)},
+		{"now", at(14, 20)},
+		{"NOW", at(14, 20)},
+		{"now-15m", at(14, 5)},
+		{"now - 1h", at(13, 20)},
+		{"now+1h30", at(15, 50)},
 	}
 
 	for _, test := range happyTests {
-		result, err := ParseTime(test.input, date)
-		if err != nil {
-			t.Errorf("ParseTime(%q) returned unexpected error: %v", test.input, err)
-		}
-		if !result.Equal(test.expected) {
-			t.Errorf("ParseTime(%q) = %v; want %v", test.input, result, test.expected)
-		}
+		t.Run(test.input, func(t *testing.T) {
+			result, err := ParseTime(test.input, date)
+			if err != nil {
+				t.Fatalf("ParseTime(%q) returned unexpected error: %v", test.input, err)
+			}
+			if !result.Equal(test.expected) {
+				t.Errorf("ParseTime(%q) = %v; want %v", test.input, result, test.expected)
+			}
+		})
 	}
 
 	// Testing if can handle errors
@@ -84,20 +177,167 @@ func TestParseTime(t *testing.T) {
 		input    string
 		expected string
 	}{
-		{"abc", "Invalid time format: \"abc\""}, // Invalid input defaults to midnight
+		{"abc", "Invalid time format: \"abc\""},
+		{"", "Invalid time format: \"\""},
+		{"13p", "Invalid time format: \"13p\""},
+		{"0a", "Invalid time format: \"0a\""},
+		{"24", "Invalid time format: \"24\""},
+		{"9:60", "Invalid time format: \"9:60\""},
+		{"9:5", "Invalid time format: \"9:5\""},
+		{"12345", "Invalid time format: \"12345\""},
+		{"9x", "Invalid time format: \"9x\""},
+		{"-9", "Invalid time format: \"-9\""},
+		{"now15m", "Invalid time format: \"now15m\""},
+		{"now-soon", "Invalid time format: \"now-soon\""},
 	}
 
 	for _, test := range invalidTests {
-		_, err := ParseTime(test.input, date)
+		t.Run("invalid "+test.input, func(t *testing.T) {
+			_, err := ParseTime(test.input, date)
+			if err == nil {
+				t.Fatalf("ParseTime(%q) expected error but got none", test.input)
+			}
+			if err.Error() != test.expected {
+				t.Errorf("ParseTime(%q) returned unexpected error: %v", test.input, err)
+			}
+		})
+	}
+}
 
-		if err != nil {
-			if err.Error() == test.expected {
-				// Expected error. Passed.
-				continue
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"1h", time.Hour, false},
+		{"+1h30", 90 * time.Minute, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"1.5h", 90 * time.Minute, false},
+		{"0.25h", 15 * time.Minute, false},
+		{"45m", 45 * time.Minute, false},
+		{"90min", 90 * time.Minute, false},
+		{"+ 2h", 2 * time.Hour, false},
+		{"9a", 0, true},
+		{"h", 0, true},
+		{"1.5", 0, true},
+		{"-1h", 0, true},
+		{"", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := ParseDuration(test.input)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("ParseDuration(%q) = %v; want an error", test.input, result)
+				}
+				return
+			}
+			if err != nil || result != test.expected {
+				t.Errorf("ParseDuration(%q) = %v, %v; want %v", test.input, result, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseEndTime(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 15, 0, 0, time.Local)
+
+	tests := []struct {
+		input    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"+1h30", time.Date(2024, 1, 1, 10, 45, 0, 0, time.Local), false},
+		{"1.5h", time.Date(2024, 1, 1, 10, 45, 0, 0, time.Local), false},
+		{"45m", time.Date(2024, 1, 1, 10, 0, 0, 0, time.Local), false},
+		{"5p", time.Date(2024, 1, 1, 17, 0, 0, 0, time.Local), false},
+		{"noon", time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local), false},
+		{"+later", time.Time{}, true},
+		{"5x", time.Time{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := ParseEndTime(test.input, start)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("ParseEndTime(%q) = %v; want an error", test.input, result)
+				}
+				return
+			}
+			if err != nil || !result.Equal(test.expected) {
+				t.Errorf("ParseEndTime(%q) = %v, %v; want %v", test.input, result, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	// A Wednesday afternoon
+	today := time.Date(2026, 10, 7, 15, 30, 0, 0, time.Local)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{"today", day(10, 7)},
+		{" Yesterday ", day(10, 6)},
+		{"-2d", day(10, 5)},
+		{"+1d", day(10, 8)},
+		{"-1w", day(9, 30)},
+		{"fri", day(10, 2)},
+		{"friday", day(10, 2)},
+		{"last fri", day(10, 2)},
+		{"wed", day(10, 7)},
+		{"last wed", day(9, 30)},
+		{"mon", day(10, 5)},
+		{"tu", day(10, 6)},
+		{"2026-10-03", day(10, 3)},
+		{"10/3", day(10, 3)},
+		{"12/31/2025", time.Date(2025, 12, 31, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := ParseDate(test.input, today)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) returned unexpected error: %v", test.input, err)
 			}
-			t.Errorf("ParseTime(%q) returned unexpected error: %v", test.input, err)
-		} else {
-			t.Errorf("ParseTime(%q) expected error but got none", test.input)
+			if !result.Equal(test.expected) {
+				t.Errorf("ParseDate(%q) = %v; want %v", test.input, result, test.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "t", "s", "last", "last week", "2026-13-01", "2/30", "-2x", "soon"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if result, err := ParseDate(input, today); err == nil {
+				t.Errorf("ParseDate(%q) = %v; want an error", input, result)
+			}
+		})
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "0:00:00"},
+		{45 * time.Second, "0:00:45"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1:02:03"},
+		{26 * time.Hour, "26:00:00"},
+		{1500 * time.Millisecond, "0:00:02"},
+	}
+
+	for _, test := range tests {
+		if result := FormatElapsed(test.input); result != test.expected {
+			t.Errorf("FormatElapsed(%v) = %q; want %q", test.input, result, test.expected)
 		}
 	}
 }
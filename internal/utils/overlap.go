@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"clockify-app/internal/models"
+	"slices"
+	"time"
+)
+
+// Span is a stretch of time from Start up to End
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Overlaps reports whether the two spans share any time
+// Spans that only touch, like 9-10 and 10-11, don't overlap.
+func (s Span) Overlaps(other Span) bool {
+	return s.Start.Before(other.End) && other.Start.Before(s.End)
+}
+
+// entrySpan returns the time an entry covers, running entries up to now
+func entrySpan(entry models.Entry) Span {
+	return Span{Start: entry.TimeInterval.Start, End: entry.TimeInterval.Start.Add(entry.Duration())}
+}
+
+// Overlaps returns the entries that share time with span, ignoring the entry with skipID
+func Overlaps(entries []models.Entry, span Span, skipID string) []models.Entry {
+	var overlapping []models.Entry
+	for _, entry := range entries {
+		if entry.ID == skipID || entry.TimeInterval.Start.IsZero() {
+			continue
+		}
+		if entrySpan(entry).Overlaps(span) {
+			overlapping = append(overlapping, entry)
+		}
+	}
+	return overlapping
+}
+
+// Trim returns the entry's span shortened so it no longer overlaps span
+// An entry that starts first keeps its start and ends where span starts, one that
+// starts inside span begins where span ends. Entries entirely inside span, entries
+// enclosing it, which would lose the time on one side, and running entries can't be trimmed.
+func Trim(entry models.Entry, span Span) (Span, bool) {
+	if entry.IsRunning() {
+		return Span{}, false
+	}
+
+	trimmed := entrySpan(entry)
+	switch {
+	case trimmed.Start.Before(span.Start) && trimmed.End.After(span.End):
+		return Span{}, false
+	case trimmed.Start.Before(span.Start):
+		trimmed.End = span.Start
+	case trimmed.End.After(span.End):
+		trimmed.Start = span.End
+	default:
+		return Span{}, false
+	}
+	return trimmed, true
+}
+
+// Gaps returns the free time between the entries, ignoring the entry with skipID
+// The time after the last entry counts as a gap up to until, when until is later.
+func Gaps(entries []models.Entry, skipID string, until time.Time) []Span {
+	var busy []Span
+	for _, entry := range entries {
+		if entry.ID == skipID || entry.TimeInterval.Start.IsZero() {
+			continue
+		}
+		busy = append(busy, entrySpan(entry))
+	}
+	if len(busy) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(busy, func(a, b Span) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	var gaps []Span
+	end := busy[0].End
+	for _, span := range busy[1:] {
+		if span.Start.After(end) {
+			gaps = append(gaps, Span{Start: end, End: span.Start})
+		}
+		if span.End.After(end) {
+			end = span.End
+		}
+	}
+	if until.After(end) {
+		gaps = append(gaps, Span{Start: end, End: until})
+	}
+	return gaps
+}
+
+// NearestGap returns the gap closest to at, the gap containing at when there is one
+func NearestGap(gaps []Span, at time.Time) (Span, bool) {
+	var nearest Span
+	best := time.Duration(-1)
+	for _, gap := range gaps {
+		var distance time.Duration
+		switch {
+		case at.Before(gap.Start):
+			distance = gap.Start.Sub(at)
+		case at.After(gap.End):
+			distance = at.Sub(gap.End)
+		}
+		if best < 0 || distance < best {
+			nearest, best = gap, distance
+		}
+	}
+	return nearest, best >= 0
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"clockify-app/internal/models"
+)
+
+func testEntry(id string, startHour, startMin, endHour, endMin int) models.Entry {
+	day := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	return models.Entry{
+		ID: id,
+		TimeInterval: models.IntervalTime{
+			Start: day.Add(time.Duration(startHour)*time.Hour + time.Duration(startMin)*time.Minute),
+			End:   day.Add(time.Duration(endHour)*time.Hour + time.Duration(endMin)*time.Minute),
+		},
+	}
+}
+
+func clock(hour, min int) time.Time {
+	return time.Date(2026, 10, 12, hour, min, 0, 0, time.Local)
+}
+
+func TestOverlaps(t *testing.T) {
+	entries := []models.Entry{
+		testEntry("a", 9, 0, 10, 0),
+		testEntry("b", 10, 0, 11, 0),
+		testEntry("c", 13, 0, 14, 0),
+	}
+
+	overlapping := Overlaps(entries, Span{Start: clock(9, 30), End: clock(10, 30)}, "")
+	if len(overlapping) != 2 {
+		t.Errorf("Expected a and b to overlap, got %v", overlapping)
+	}
+
+	// Touching isn't overlapping
+	if overlapping := Overlaps(entries, Span{Start: clock(11, 0), End: clock(13, 0)}, ""); len(overlapping) != 0 {
+		t.Errorf("Expected no overlaps, got %v", overlapping)
+	}
+
+	// The entry being edited doesn't overlap itself
+	if overlapping := Overlaps(entries, Span{Start: clock(13, 0), End: clock(13, 30)}, "c"); len(overlapping) != 0 {
+		t.Errorf("Expected the skipped entry to be ignored, got %v", overlapping)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	span := Span{Start: clock(10, 0), End: clock(11, 0)}
+
+	tests := []struct {
+		name     string
+		entry    models.Entry
+		expected Span
+		ok       bool
+	}{
+		{"starts before", testEntry("a", 9, 0, 10, 30), Span{Start: clock(9, 0), End: clock(10, 0)}, true},
+		{"ends after", testEntry("b", 10, 30, 12, 0), Span{Start: clock(11, 0), End: clock(12, 0)}, true},
+		{"inside", testEntry("c", 10, 15, 10, 45), Span{}, false},
+		{"encloses", testEntry("d", 9, 0, 17, 0), Span{}, false},
+		{"running", models.Entry{TimeInterval: models.IntervalTime{Start: clock(9, 0)}}, Span{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trimmed, ok := Trim(test.entry, span)
+			if ok != test.ok || trimmed != test.expected {
+				t.Errorf("Trim() = %v, %v; want %v, %v", trimmed, ok, test.expected, test.ok)
+			}
+		})
+	}
+}
+
+func TestGaps(t *testing.T) {
+	entries := []models.Entry{
+		testEntry("c", 13, 0, 14, 0),
+		testEntry("a", 9, 0, 10, 0),
+		testEntry("b", 9, 30, 11, 0),
+		testEntry("edited", 11, 0, 12, 0),
+	}
+
+	gaps := Gaps(entries, "edited", clock(17, 0))
+	expected := []Span{
+		{Start: clock(11, 0), End: clock(13, 0)},
+		{Start: clock(14, 0), End: clock(17, 0)},
+	}
+	if len(gaps) != len(expected) || gaps[0] != expected[0] || gaps[1] != expected[1] {
+		t.Fatalf("Gaps() = %v; want %v", gaps, expected)
+	}
+
+	if gap, ok := NearestGap(gaps, clock(12, 30)); !ok || gap != expected[0] {
+		t.Errorf("Expected the gap containing 12:30, got %v", gap)
+	}
+	if gap, ok := NearestGap(gaps, clock(18, 0)); !ok || gap != expected[1] {
+		t.Errorf("Expected the closest gap to 18:00, got %v", gap)
+	}
+	if _, ok := NearestGap(nil, clock(12, 0)); ok {
+		t.Error("Expected no gap without entries")
+	}
+}